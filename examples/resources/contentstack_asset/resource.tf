@@ -0,0 +1,26 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_asset_folder" "logos" {
+  name = "Logos"
+}
+
+resource "contentstack_asset" "logo" {
+  file   = "${path.module}/assets/logo.svg"
+  title  = "Logo"
+  folder = contentstack_asset_folder.logos.uid
+  tags   = ["brand"]
+}
@@ -0,0 +1,24 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_asset_folder" "brand" {
+  name = "Brand"
+}
+
+resource "contentstack_asset_folder" "logos" {
+  name   = "Logos"
+  parent = contentstack_asset_folder.brand.uid
+}
@@ -0,0 +1,20 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_branch" "feature" {
+  uid    = "feature_x"
+  source = "main"
+}
@@ -0,0 +1,25 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_branch" "release" {
+  uid    = "release_2026_10"
+  source = "main"
+}
+
+resource "contentstack_branch_alias" "production" {
+  uid           = "production"
+  target_branch = contentstack_branch.release.uid
+}
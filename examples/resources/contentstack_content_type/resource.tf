@@ -19,7 +19,7 @@ resource "contentstack_content_type" "my_field" {
   uid         = "foobar"
   description = "someting"
 
-  schema = jsonencode(jsondecode(<<JSON
+  schema = <<JSON
     [
       {
         "display_name": "Name",
@@ -49,5 +49,84 @@ resource "contentstack_content_type" "my_field" {
       }
     ]
 JSON
-  ))
+}
+
+resource "contentstack_content_type" "article" {
+  title       = "Article"
+  uid         = "article"
+  description = "A news article"
+
+  options {
+    is_page     = true
+    url_prefix  = "/news/"
+    url_pattern = "/:year/:title"
+  }
+
+  field {
+    uid          = "title"
+    display_name = "Title"
+    type         = "text"
+    mandatory    = true
+    unique       = true
+  }
+
+  field {
+    uid          = "category"
+    display_name = "Category"
+    type         = "select"
+    choices      = ["news", "blog"]
+  }
+
+  field {
+    uid          = "body"
+    display_name = "Body"
+    type         = "json_rte"
+  }
+
+  field {
+    uid          = "seo"
+    display_name = "SEO"
+    type         = "group"
+
+    fields = [
+      {
+        uid          = "meta_title"
+        display_name = "Meta title"
+        type         = "text"
+      },
+      {
+        uid          = "meta_description"
+        display_name = "Meta description"
+        type         = "text"
+        multiline    = true
+      },
+    ]
+  }
+
+  field {
+    uid          = "related"
+    display_name = "Related articles"
+    type         = "reference"
+    multiple     = true
+    reference_to = ["article"]
+  }
+
+  field_rule {
+    match_type = "all"
+
+    conditions = [
+      {
+        operand_field = "category"
+        operator      = "equals"
+        value         = "blog"
+      },
+    ]
+
+    actions = [
+      {
+        action       = "hide"
+        target_field = "related"
+      },
+    ]
+  }
 }
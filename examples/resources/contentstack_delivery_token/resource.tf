@@ -0,0 +1,31 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_environment" "production" {
+  name = "production"
+}
+
+resource "contentstack_delivery_token" "website" {
+  name         = "Website"
+  description  = "Used by the website to fetch published content"
+  environments = [contentstack_environment.production.name]
+  branches     = ["main"]
+}
+
+output "delivery_token" {
+  value     = contentstack_delivery_token.website.token
+  sensitive = true
+}
@@ -0,0 +1,41 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_entry" "site_settings" {
+  content_type_uid = "site_settings"
+  locale           = "en-us"
+  ignore_keys      = ["announcement"]
+
+  fields = jsonencode({
+    title            = "Site settings"
+    maintenance_mode = false
+    footer = {
+      copyright = "ACME Inc."
+    }
+  })
+
+  localization {
+    locale = "nl-nl"
+
+    fields = jsonencode({
+      title            = "Site-instellingen"
+      maintenance_mode = false
+      footer = {
+        copyright = "ACME B.V."
+      }
+    })
+  }
+}
@@ -0,0 +1,32 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_entry" "site_settings" {
+  content_type_uid = "site_settings"
+
+  fields = jsonencode({
+    title            = "Site settings"
+    maintenance_mode = false
+  })
+}
+
+resource "contentstack_entry_publish" "site_settings" {
+  content_type_uid = contentstack_entry.site_settings.content_type_uid
+  entry_uid        = contentstack_entry.site_settings.uid
+  version          = contentstack_entry.site_settings.version
+  environments     = ["staging", "production"]
+  locales          = ["en-us"]
+}
@@ -0,0 +1,44 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_extension" "color_picker" {
+  title     = "Color picker"
+  type      = "field"
+  src       = "https://extensions.example.com/color-picker.html"
+  data_type = "text"
+  tags      = ["design"]
+
+  config = jsonencode({
+    palette = ["#ffffff", "#000000", "#2196f3"]
+  })
+}
+
+resource "contentstack_content_type" "theme" {
+  title = "Theme"
+  uid   = "theme"
+
+  schema = jsonencode([
+    {
+      data_type     = "text"
+      display_name  = "Primary color"
+      uid           = "primary_color"
+      extension_uid = contentstack_extension.color_picker.uid
+      field_metadata = {
+        extension = true
+      }
+    }
+  ])
+}
@@ -20,7 +20,7 @@ resource "contentstack_global_field" "my_field" {
   description        = "someting"
   maintain_revisions = true
 
-  schema = jsonencode(jsondecode(<<JSON
+  schema = <<JSON
     [
       {
         "display_name": "Name",
@@ -50,5 +50,4 @@ resource "contentstack_global_field" "my_field" {
       }
     ]
 JSON
-  ))
 }
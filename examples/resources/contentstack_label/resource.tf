@@ -0,0 +1,25 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_label" "pages" {
+  name = "Pages"
+}
+
+resource "contentstack_label" "landing_pages" {
+  name          = "Landing pages"
+  parent        = contentstack_label.pages.uid
+  content_types = ["landing_page", "campaign_page"]
+}
@@ -0,0 +1,29 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_environment" "preview" {
+  name = "preview"
+}
+
+resource "contentstack_live_preview_settings" "settings" {
+  default_environment = contentstack_environment.preview.uid
+  default_url         = "https://preview.example.com"
+
+  content_type_url {
+    content_type = "article"
+    url          = "https://preview.example.com/news"
+  }
+}
@@ -0,0 +1,27 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_management_token" "deploy" {
+  name             = "Deploy"
+  description      = "Used by the deployment pipeline"
+  content_type_acl = "read_write"
+  branch_aliases   = ["deploy"]
+  expires_on       = "2027-12-31"
+
+  rotate_when_changed = {
+    rotation = "2026-q4"
+  }
+}
@@ -0,0 +1,24 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_delivery_token" "website" {
+  name         = "Website"
+  environments = ["production"]
+}
+
+resource "contentstack_preview_token" "website" {
+  delivery_token_uid = contentstack_delivery_token.website.uid
+}
@@ -0,0 +1,25 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_publish_rule" "production" {
+  workflow       = "blt1a2b3c4d5e6f7a8b"
+  workflow_stage = "Approved"
+  environment    = "blt2b3c4d5e6f7a8b9c"
+  content_types  = ["$all"]
+  locales        = ["en-us"]
+  actions        = ["publish", "unpublish"]
+  approver_roles = ["blt3c4d5e6f7a8b9c0d"]
+}
@@ -0,0 +1,42 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_release" "launch" {
+  name        = "Launch"
+  description = "Pages for the launch of the new website"
+  locked      = true
+
+  item {
+    uid          = "blt1a2b3c4d5e6f7a8b"
+    version      = 3
+    content_type = "page"
+    locale       = "en-us"
+    action       = "publish"
+  }
+
+  item {
+    uid          = "blt2b3c4d5e6f7a8b9c"
+    version      = 1
+    content_type = "built_io_upload"
+    locale       = "en-us"
+    action       = "publish"
+  }
+
+  deploy {
+    environments = ["production"]
+    locales      = ["en-us"]
+  }
+}
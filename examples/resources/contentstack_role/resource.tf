@@ -0,0 +1,55 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_role" "editor" {
+  name           = "Editor"
+  description    = "Can edit and publish pages"
+  deploy_content = true
+
+  rule {
+    module  = "content_type"
+    targets = ["page"]
+
+    acl = {
+      read = true
+    }
+
+    sub_acl = {
+      read    = true
+      create  = true
+      update  = true
+      publish = true
+    }
+  }
+
+  rule {
+    module  = "environment"
+    targets = ["$all"]
+
+    acl = {
+      read = true
+    }
+  }
+
+  rule {
+    module  = "locale"
+    targets = ["$all"]
+
+    acl = {
+      read = true
+    }
+  }
+}
@@ -0,0 +1,21 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_taxonomy" "product_categories" {
+  uid         = "product_categories"
+  name        = "Product categories"
+  description = "The categories of the products in the webshop."
+}
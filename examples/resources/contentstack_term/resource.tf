@@ -0,0 +1,54 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_taxonomy" "product_categories" {
+  uid  = "product_categories"
+  name = "Product categories"
+}
+
+resource "contentstack_term" "clothing" {
+  taxonomy_uid = contentstack_taxonomy.product_categories.uid
+  uid          = "clothing"
+  name         = "Clothing"
+  order        = 1
+}
+
+resource "contentstack_term" "shoes" {
+  taxonomy_uid = contentstack_taxonomy.product_categories.uid
+  uid          = "shoes"
+  name         = "Shoes"
+  parent_uid   = contentstack_term.clothing.uid
+}
+
+resource "contentstack_content_type" "product" {
+  uid   = "product"
+  title = "Product"
+
+  field {
+    uid          = "title"
+    display_name = "Title"
+    type         = "text"
+    mandatory    = true
+    unique       = true
+  }
+
+  field {
+    uid          = "categories"
+    display_name = "Categories"
+    type         = "taxonomy"
+    taxonomies   = [contentstack_taxonomy.product_categories.uid]
+  }
+}
@@ -0,0 +1,41 @@
+
+terraform {
+  required_providers {
+    contentstack = {
+      source = "labd/contentstack"
+    }
+  }
+}
+
+provider "contentstack" {
+  base_url         = "https://eu-api.contentstack.com/"
+  api_key          = "<api_key>"
+  management_token = "<token>"
+}
+
+
+resource "contentstack_workflow" "review" {
+  name          = "Review"
+  description   = "Review of pages before publishing"
+  content_types = ["page"]
+
+  stage {
+    name                  = "Draft"
+    color                 = "#9e9e9e"
+    next_available_stages = ["Review"]
+  }
+
+  stage {
+    name                  = "Review"
+    color                 = "#2196f3"
+    sla                   = 2
+    allowed_roles         = ["blt3c4d5e6f7a8b9c0d"]
+    next_available_stages = ["Draft", "Approved"]
+  }
+
+  stage {
+    name          = "Approved"
+    color         = "#4caf50"
+    allowed_roles = ["blt3c4d5e6f7a8b9c0d"]
+  }
+}
@@ -0,0 +1,165 @@
+package contentstack
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Asset is a file uploaded to the stack. Contentstack returns the file size
+// as string.
+type Asset struct {
+	UID         string   `json:"uid"`
+	Filename    string   `json:"filename"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	ParentUID   string   `json:"parent_uid"`
+	URL         string   `json:"url"`
+	FileSize    int64    `json:"file_size,string"`
+	Tags        []string `json:"tags"`
+}
+
+// AssetInput is used to create or update an asset. The file is uploaded when
+// set, which is required to create an asset.
+type AssetInput struct {
+	File        io.Reader
+	Filename    string
+	Title       string
+	Description string
+	ParentUID   string
+	Tags        []string
+}
+
+type assetResponse struct {
+	Asset Asset `json:"asset"`
+}
+
+func (s *Stack) AssetCreate(ctx context.Context, input AssetInput) (*Asset, error) {
+	result := &assetResponse{}
+	err := s.upload(ctx, http.MethodPost, "/v3/assets", input, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Asset, nil
+}
+
+func (s *Stack) AssetUpdate(ctx context.Context, uid string, input AssetInput) (*Asset, error) {
+	result := &assetResponse{}
+	err := s.upload(ctx, http.MethodPut, fmt.Sprintf("/v3/assets/%s", uid), input, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Asset, nil
+}
+
+func (s *Stack) AssetFetch(ctx context.Context, uid string) (*Asset, error) {
+	result := &assetResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/assets/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Asset, nil
+}
+
+func (s *Stack) AssetDelete(ctx context.Context, uid string) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/assets/%s", uid), url.Values{}, nil, nil)
+}
+
+// upload sends the asset as multipart form, which is the only format accepted
+// for assets.
+func (s *Stack) upload(ctx context.Context, method string, path string, input AssetInput, dst interface{}) error {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if input.File != nil {
+		part, err := writer.CreateFormFile("asset[upload]", input.Filename)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(part, input.File); err != nil {
+			return fmt.Errorf("Unable to read file: %w", err)
+		}
+	}
+
+	fields := [][2]string{
+		{"asset[title]", input.Title},
+		{"asset[description]", input.Description},
+		{"asset[parent_uid]", input.ParentUID},
+		{"asset[tags]", strings.Join(input.Tags, ",")},
+	}
+	for _, field := range fields {
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	req, err := s.newRequest(ctx, method, path, url.Values{}, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return s.do(req, dst)
+}
+
+// AssetFolder is a folder to organise the assets of the stack.
+type AssetFolder struct {
+	UID       string `json:"uid"`
+	Name      string `json:"name"`
+	ParentUID string `json:"parent_uid"`
+}
+
+// AssetFolderInput is used to create or update an asset folder. The folder is
+// created at the root when ParentUID is empty.
+type AssetFolderInput struct {
+	Name      string `json:"name"`
+	ParentUID string `json:"parent_uid,omitempty"`
+}
+
+// Folders are wrapped as asset like the files.
+type assetFolderRequest struct {
+	AssetFolder AssetFolderInput `json:"asset"`
+}
+
+type assetFolderResponse struct {
+	AssetFolder AssetFolder `json:"asset"`
+}
+
+func (s *Stack) AssetFolderCreate(ctx context.Context, input AssetFolderInput) (*AssetFolder, error) {
+	result := &assetFolderResponse{}
+	err := s.post(ctx, "/v3/assets/folders", url.Values{}, assetFolderRequest{AssetFolder: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.AssetFolder, nil
+}
+
+func (s *Stack) AssetFolderUpdate(ctx context.Context, uid string, input AssetFolderInput) (*AssetFolder, error) {
+	result := &assetFolderResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/assets/folders/%s", uid), url.Values{}, assetFolderRequest{AssetFolder: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.AssetFolder, nil
+}
+
+func (s *Stack) AssetFolderFetch(ctx context.Context, uid string) (*AssetFolder, error) {
+	result := &assetFolderResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/assets/folders/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.AssetFolder, nil
+}
+
+func (s *Stack) AssetFolderDelete(ctx context.Context, uid string) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/assets/folders/%s", uid), url.Values{}, nil, nil)
+}
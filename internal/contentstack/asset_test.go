@@ -0,0 +1,63 @@
+package contentstack
+
+import (
+	"context"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetCreate(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusCreated, `{"asset": {"uid": "blt1", "filename": "logo.svg", "title": "Logo", "file_size": "11", "tags": ["logo"]}}`)
+
+	asset, err := stack.AssetCreate(context.Background(), AssetInput{
+		File:      strings.NewReader("<svg></svg>"),
+		Filename:  "logo.svg",
+		Title:     "Logo",
+		ParentUID: "blt2",
+		Tags:      []string{"logo", "brand"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "blt1", asset.UID)
+	assert.Equal(t, int64(11), asset.FileSize)
+
+	req := (*requests)[0]
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.Equal(t, "/v3/assets", req.Path)
+
+	mediaType, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/form-data", mediaType)
+
+	form, err := multipart.NewReader(strings.NewReader(req.Body), params["boundary"]).ReadForm(1024)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Logo"}, form.Value["asset[title]"])
+	assert.Equal(t, []string{"blt2"}, form.Value["asset[parent_uid]"])
+	assert.Equal(t, []string{"logo,brand"}, form.Value["asset[tags]"])
+
+	require.Len(t, form.File["asset[upload]"], 1)
+	assert.Equal(t, "logo.svg", form.File["asset[upload]"][0].Filename)
+	file, err := form.File["asset[upload]"][0].Open()
+	require.NoError(t, err)
+	content, err := ioutil.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, "<svg></svg>", string(content))
+}
+
+func TestAssetUpdateWithoutFile(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusOK, `{"asset": {"uid": "blt1", "filename": "logo.svg", "title": "Logo", "file_size": "11"}}`)
+
+	_, err := stack.AssetUpdate(context.Background(), "blt1", AssetInput{Filename: "logo.svg", Title: "Logo"})
+	require.NoError(t, err)
+
+	req := (*requests)[0]
+	assert.Equal(t, http.MethodPut, req.Method)
+	assert.Equal(t, "/v3/assets/blt1", req.Path)
+	assert.NotContains(t, req.Body, "asset[upload]")
+}
@@ -0,0 +1,53 @@
+package contentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Branch is a branch of the stack.
+type Branch struct {
+	UID    string `json:"uid"`
+	Source string `json:"source"`
+}
+
+// BranchInput is used to create a branch.
+type BranchInput struct {
+	UID    string `json:"uid"`
+	Source string `json:"source"`
+}
+
+type branchRequest struct {
+	Branch BranchInput `json:"branch"`
+}
+
+type branchResponse struct {
+	Branch Branch `json:"branch"`
+}
+
+// BranchCreate starts the creation of a branch. The branch is created
+// asynchronously, it can only be fetched once all content is copied.
+func (s *Stack) BranchCreate(ctx context.Context, input BranchInput) (*Branch, error) {
+	result := &branchResponse{}
+	err := s.post(ctx, "/v3/stacks/branches", url.Values{}, branchRequest{Branch: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Branch, nil
+}
+
+func (s *Stack) BranchFetch(ctx context.Context, uid string) (*Branch, error) {
+	result := &branchResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/stacks/branches/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Branch, nil
+}
+
+// BranchDelete starts the deletion of a branch, including all of its content.
+func (s *Stack) BranchDelete(ctx context.Context, uid string) error {
+	params := url.Values{"force": []string{"true"}}
+	return s.delete(ctx, fmt.Sprintf("/v3/stacks/branches/%s", uid), params, nil, nil)
+}
@@ -0,0 +1,61 @@
+package contentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// BranchAlias is an alias pointing to a branch of the stack.
+type BranchAlias struct {
+	UID          string
+	TargetBranch string
+}
+
+// BranchAliasInput is used to assign an alias to a branch.
+type BranchAliasInput struct {
+	TargetBranch string `json:"target_branch"`
+}
+
+type branchAliasRequest struct {
+	BranchAlias BranchAliasInput `json:"branch_alias"`
+}
+
+// branchAliasResponse holds the branch the alias points to.
+type branchAliasResponse struct {
+	BranchAlias struct {
+		UID string `json:"uid"`
+	} `json:"branch_alias"`
+}
+
+func (r *branchAliasResponse) alias(uid string) *BranchAlias {
+	return &BranchAlias{
+		UID:          uid,
+		TargetBranch: r.BranchAlias.UID,
+	}
+}
+
+// BranchAliasAssign assigns the alias to the target branch. The alias is
+// created when it doesn't exist yet.
+func (s *Stack) BranchAliasAssign(ctx context.Context, uid string, input BranchAliasInput) (*BranchAlias, error) {
+	result := &branchAliasResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/stacks/branch_aliases/%s", uid), url.Values{}, branchAliasRequest{BranchAlias: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result.alias(uid), nil
+}
+
+func (s *Stack) BranchAliasFetch(ctx context.Context, uid string) (*BranchAlias, error) {
+	result := &branchAliasResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/stacks/branch_aliases/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result.alias(uid), nil
+}
+
+func (s *Stack) BranchAliasDelete(ctx context.Context, uid string) error {
+	params := url.Values{"force": []string{"true"}}
+	return s.delete(ctx, fmt.Sprintf("/v3/stacks/branch_aliases/%s", uid), params, nil, nil)
+}
@@ -0,0 +1,23 @@
+package contentstack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBranchAliasAssign(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusOK, `{"branch_alias": {"uid": "release", "source": "main", "alias": [{"uid": "deploy"}]}}`)
+
+	alias, err := stack.BranchAliasAssign(context.Background(), "deploy", BranchAliasInput{TargetBranch: "release"})
+	require.NoError(t, err)
+	assert.Equal(t, &BranchAlias{UID: "deploy", TargetBranch: "release"}, alias)
+
+	req := (*requests)[0]
+	assert.Equal(t, http.MethodPut, req.Method)
+	assert.Equal(t, "/v3/stacks/branch_aliases/deploy", req.Path)
+	assert.JSONEq(t, `{"branch_alias": {"target_branch": "release"}}`, req.Body)
+}
@@ -0,0 +1,34 @@
+package contentstack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBranchCreate(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusCreated, `{"notice": "Branch creation in progress.", "branch": {"uid": "development", "source": "main"}}`)
+
+	branch, err := stack.BranchCreate(context.Background(), BranchInput{UID: "development", Source: "main"})
+	require.NoError(t, err)
+	assert.Equal(t, &Branch{UID: "development", Source: "main"}, branch)
+
+	req := (*requests)[0]
+	assert.Equal(t, "/v3/stacks/branches", req.Path)
+	assert.JSONEq(t, `{"branch": {"uid": "development", "source": "main"}}`, req.Body)
+}
+
+func TestBranchDelete(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusOK, `{"notice": "Branch deletion in progress."}`)
+
+	err := stack.BranchDelete(context.Background(), "development")
+	require.NoError(t, err)
+
+	req := (*requests)[0]
+	assert.Equal(t, http.MethodDelete, req.Method)
+	assert.Equal(t, "/v3/stacks/branches/development", req.Path)
+	assert.Equal(t, "force=true", req.Query)
+}
@@ -0,0 +1,167 @@
+// Package contentstack extends the stack instance of the contentstack-go-sdk
+// with the parts of the Content Management API which the SDK doesn't support.
+// Requests use the same configuration as the SDK, and errors are returned as
+// *management.ErrorMessage so they can be handled the same way.
+package contentstack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/labd/contentstack-go-sdk/management"
+)
+
+// Stack is a stack instance of the SDK with additional endpoints.
+type Stack struct {
+	*management.StackInstance
+
+	baseURL    *url.URL
+	httpClient *http.Client
+	authToken  string
+	auth       management.StackAuth
+}
+
+// NewStack returns the stack for the stack instance, which is created with the
+// given client configuration and authentication.
+func NewStack(instance *management.StackInstance, cfg management.ClientConfig, auth management.StackAuth) (*Stack, error) {
+	baseURL, err := url.Parse(cfg.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+
+	stack := &Stack{
+		StackInstance: instance,
+		baseURL:       baseURL,
+		httpClient:    httpClient,
+		authToken:     cfg.AuthToken,
+		auth:          auth,
+	}
+	return stack, nil
+}
+
+func (s *Stack) get(ctx context.Context, path string, params url.Values, dst interface{}) error {
+	return s.execute(ctx, http.MethodGet, path, params, nil, dst)
+}
+
+func (s *Stack) post(ctx context.Context, path string, params url.Values, input interface{}, dst interface{}) error {
+	return s.execute(ctx, http.MethodPost, path, params, input, dst)
+}
+
+func (s *Stack) put(ctx context.Context, path string, params url.Values, input interface{}, dst interface{}) error {
+	return s.execute(ctx, http.MethodPut, path, params, input, dst)
+}
+
+func (s *Stack) delete(ctx context.Context, path string, params url.Values, input interface{}, dst interface{}) error {
+	return s.execute(ctx, http.MethodDelete, path, params, input, dst)
+}
+
+// execute sends the input as JSON and decodes the response into dst. The input
+// and dst are optional.
+func (s *Stack) execute(ctx context.Context, method string, path string, params url.Values, input interface{}, dst interface{}) error {
+	var body io.Reader
+	if input != nil {
+		data, err := json.Marshal(input)
+		if err != nil {
+			return fmt.Errorf("Unable to serialize content: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := s.newRequest(ctx, method, path, params, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	return s.do(req, dst)
+}
+
+// newRequest creates a request to the stack with the authentication headers
+// set.
+func (s *Stack) newRequest(ctx context.Context, method string, path string, params url.Values, body io.Reader) (*http.Request, error) {
+	endpoint, err := s.baseURL.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	if params != nil {
+		endpoint.RawQuery = params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("Creating new request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json; charset=utf-8")
+	req.Header.Set("api_key", s.auth.ApiKey)
+	if s.auth.ManagementToken != "" {
+		req.Header.Set("authorization", s.auth.ManagementToken)
+	} else {
+		req.Header.Set("authtoken", s.authToken)
+	}
+	if s.auth.Branch != "" {
+		req.Header.Set("branch", s.auth.Branch)
+	}
+	return req, nil
+}
+
+func (s *Stack) do(req *http.Request, dst interface{}) error {
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return processResponse(resp.StatusCode, content, dst)
+}
+
+// processResponse decodes the content of a successful response into dst, or
+// returns the error of a failed response.
+func processResponse(status int, content []byte, dst interface{}) error {
+	if status >= 200 && status < 300 {
+		if dst == nil || len(content) == 0 {
+			return nil
+		}
+		return json.Unmarshal(content, dst)
+	}
+
+	if status == http.StatusNotFound {
+		return &management.ErrorMessage{
+			ErrorMessage: "Resource not found",
+			ErrorCode:    404,
+		}
+	}
+
+	// Contentstack doesn't always return the errors per field, so these are
+	// decoded separately.
+	result := struct {
+		ErrorMessage string          `json:"error_message"`
+		ErrorCode    int             `json:"error_code"`
+		Errors       json.RawMessage `json:"errors"`
+	}{}
+	if err := json.Unmarshal(content, &result); err != nil || result.ErrorMessage == "" {
+		return fmt.Errorf("Unhandled StatusCode: %d", status)
+	}
+
+	errorMessage := &management.ErrorMessage{
+		ErrorMessage: result.ErrorMessage,
+		ErrorCode:    result.ErrorCode,
+	}
+	_ = json.Unmarshal(result.Errors, &errorMessage.Errors)
+	return errorMessage
+}
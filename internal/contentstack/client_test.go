@@ -0,0 +1,108 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labd/contentstack-go-sdk/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRequest is a request as received by the test server.
+type testRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Header http.Header
+	Body   string
+}
+
+// newTestStack returns a stack which sends its requests to a test server. The
+// server records the requests and responds with the given status and body.
+func newTestStack(t *testing.T, status int, body string) (*Stack, *[]testRequest) {
+	requests := []testRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, testRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.RawQuery,
+			Header: r.Header,
+			Body:   string(content),
+		})
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := management.ClientConfig{BaseURL: server.URL}
+	auth := management.StackAuth{
+		ApiKey:          "api-key",
+		ManagementToken: "token",
+		Branch:          "development",
+	}
+
+	client, err := management.NewClient(cfg)
+	require.NoError(t, err)
+	instance, err := client.Stack(&auth)
+	require.NoError(t, err)
+	stack, err := NewStack(instance, cfg, auth)
+	require.NoError(t, err)
+	return stack, &requests
+}
+
+func TestStackRequestHeaders(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusOK, `{"content_type": {"uid": "page", "field_rules": []}}`)
+
+	title := "Page"
+	result, err := stack.ContentTypeUpdate(context.Background(), "page", ContentTypeInput{
+		Title: &title,
+		Options: &management.ContentTypeOptions{
+			IsPage:     true,
+			UrlPattern: "/:title",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "page", result.UID)
+	assert.JSONEq(t, `[]`, string(result.FieldRules))
+
+	require.Len(t, *requests, 1)
+	req := (*requests)[0]
+	assert.Equal(t, http.MethodPut, req.Method)
+	assert.Equal(t, "/v3/content_types/page", req.Path)
+	assert.Equal(t, "api-key", req.Header.Get("api_key"))
+	assert.Equal(t, "token", req.Header.Get("authorization"))
+	assert.Equal(t, "development", req.Header.Get("branch"))
+
+	var body map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(req.Body), &body))
+	assert.Equal(t, "Page", body["content_type"]["title"])
+	assert.Equal(t, "/:title", body["content_type"]["options"].(map[string]interface{})["url_pattern"])
+}
+
+func TestProcessResponse(t *testing.T) {
+	err := processResponse(http.StatusNoContent, nil, &struct{}{})
+	assert.NoError(t, err)
+
+	err = processResponse(http.StatusNotFound, []byte(`{}`), nil)
+	assert.Equal(t, &management.ErrorMessage{ErrorMessage: "Resource not found", ErrorCode: 404}, err)
+
+	err = processResponse(http.StatusUnprocessableEntity, []byte(`{"error_message": "Invalid", "error_code": 115, "errors": {"uid": ["is not unique"]}}`), nil)
+	assert.Equal(t, &management.ErrorMessage{
+		ErrorMessage: "Invalid",
+		ErrorCode:    115,
+		Errors:       map[string][]string{"uid": {"is not unique"}},
+	}, err)
+
+	// Errors which are not per field are left out.
+	err = processResponse(http.StatusUnprocessableEntity, []byte(`{"error_message": "Invalid", "error_code": 115, "errors": [{}]}`), nil)
+	assert.Equal(t, &management.ErrorMessage{ErrorMessage: "Invalid", ErrorCode: 115}, err)
+
+	err = processResponse(http.StatusBadGateway, []byte(`<html></html>`), nil)
+	assert.EqualError(t, err, "Unhandled StatusCode: 502")
+}
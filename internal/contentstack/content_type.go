@@ -0,0 +1,69 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/labd/contentstack-go-sdk/management"
+)
+
+// ContentType is the content type of the SDK including its field rules.
+type ContentType struct {
+	management.ContentType
+	FieldRules json.RawMessage `json:"field_rules"`
+}
+
+// ContentTypeInput is used to create or update a content type. Unlike the
+// input of the SDK it includes the options and field rules of the content
+// type.
+type ContentTypeInput struct {
+	Title       *string                        `json:"title,omitempty"`
+	UID         *string                        `json:"uid,omitempty"`
+	Description *string                        `json:"description,omitempty"`
+	Schema      json.RawMessage                `json:"schema,omitempty"`
+	Options     *management.ContentTypeOptions `json:"options,omitempty"`
+	FieldRules  json.RawMessage                `json:"field_rules,omitempty"`
+}
+
+type contentTypeRequest struct {
+	ContentType ContentTypeInput `json:"content_type"`
+}
+
+type contentTypeResponse struct {
+	ContentType ContentType `json:"content_type"`
+}
+
+// ContentTypeCreate creates a content type, replacing the method of the SDK
+// which doesn't send the options and field rules.
+func (s *Stack) ContentTypeCreate(ctx context.Context, input ContentTypeInput) (*ContentType, error) {
+	result := &contentTypeResponse{}
+	err := s.post(ctx, "/v3/content_types/", url.Values{}, contentTypeRequest{ContentType: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.ContentType, nil
+}
+
+// ContentTypeUpdate updates a content type, replacing the method of the SDK
+// which doesn't send the options and field rules.
+func (s *Stack) ContentTypeUpdate(ctx context.Context, uid string, input ContentTypeInput) (*ContentType, error) {
+	result := &contentTypeResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/content_types/%s", uid), url.Values{}, contentTypeRequest{ContentType: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.ContentType, nil
+}
+
+// ContentTypeFetch fetches a content type, replacing the method of the SDK
+// which doesn't return the field rules.
+func (s *Stack) ContentTypeFetch(ctx context.Context, uid string) (*ContentType, error) {
+	result := &contentTypeResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/content_types/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.ContentType, nil
+}
@@ -0,0 +1,81 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// DeliveryToken is a delivery token of the stack. The scope is kept as JSON,
+// its structure depends on the module it applies to. The preview token is
+// empty unless it was created for the delivery token.
+type DeliveryToken struct {
+	UID          string          `json:"uid"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Token        string          `json:"token"`
+	PreviewToken string          `json:"preview_token"`
+	Scope        json.RawMessage `json:"scope"`
+}
+
+// DeliveryTokenInput is used to create or update a delivery token.
+type DeliveryTokenInput struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Scope       json.RawMessage `json:"scope"`
+}
+
+type deliveryTokenRequest struct {
+	Token DeliveryTokenInput `json:"token"`
+}
+
+type deliveryTokenResponse struct {
+	Token DeliveryToken `json:"token"`
+}
+
+func (s *Stack) DeliveryTokenCreate(ctx context.Context, input DeliveryTokenInput) (*DeliveryToken, error) {
+	result := &deliveryTokenResponse{}
+	err := s.post(ctx, "/v3/stacks/delivery_tokens", url.Values{}, deliveryTokenRequest{Token: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Token, nil
+}
+
+func (s *Stack) DeliveryTokenUpdate(ctx context.Context, uid string, input DeliveryTokenInput) (*DeliveryToken, error) {
+	result := &deliveryTokenResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/stacks/delivery_tokens/%s", uid), url.Values{}, deliveryTokenRequest{Token: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Token, nil
+}
+
+func (s *Stack) DeliveryTokenFetch(ctx context.Context, uid string) (*DeliveryToken, error) {
+	result := &deliveryTokenResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/stacks/delivery_tokens/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Token, nil
+}
+
+func (s *Stack) DeliveryTokenDelete(ctx context.Context, uid string) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/stacks/delivery_tokens/%s", uid), url.Values{}, nil, nil)
+}
+
+// PreviewTokenCreate creates the preview token of the delivery token, which is
+// returned as part of the delivery token.
+func (s *Stack) PreviewTokenCreate(ctx context.Context, uid string) (*DeliveryToken, error) {
+	result := &deliveryTokenResponse{}
+	err := s.post(ctx, fmt.Sprintf("/v3/stacks/delivery_tokens/%s/preview_token", uid), url.Values{}, nil, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Token, nil
+}
+
+func (s *Stack) PreviewTokenDelete(ctx context.Context, uid string) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/stacks/delivery_tokens/%s/preview_token", uid), url.Values{}, nil, nil)
+}
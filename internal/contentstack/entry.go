@@ -0,0 +1,118 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/labd/contentstack-go-sdk/management"
+)
+
+// entryKeys are the keys which are not part of the fields of an entry, the
+// same keys as removed by the SDK.
+var entryKeys = []string{
+	"tags", "locale", "uid", "created_by", "updated_by", "created_at",
+	"updated_at", "ACL", "_version", "_in_progress", "publish_details",
+}
+
+type entryResponse struct {
+	Entry json.RawMessage `json:"entry"`
+}
+
+// EntryFetch fetches an entry in the locale of the input, replacing the
+// method of the SDK which always returns the entry in the master locale.
+// Contentstack returns the entry in the fallback locale when the entry is not
+// localized.
+func (s *Stack) EntryFetch(ctx context.Context, input *management.EntryContextInput) (*management.Entry, error) {
+	params := url.Values{}
+	if input.Locale != "" {
+		params.Set("locale", input.Locale)
+	}
+
+	result := &entryResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/content_types/%s/entries/%s", input.ContentTypeUID, input.UID), params, result)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &management.Entry{}
+	if err := json.Unmarshal(result.Entry, entry); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(result.Entry, &entry.Fields); err != nil {
+		return nil, err
+	}
+	for _, key := range entryKeys {
+		delete(entry.Fields, key)
+	}
+	return entry, nil
+}
+
+// EntryUnlocalize removes the localized version of the entry in the locale of
+// the input. The entry falls back to the master locale afterwards.
+func (s *Stack) EntryUnlocalize(ctx context.Context, input *management.EntryContextInput) error {
+	params := url.Values{}
+	params.Set("locale", input.Locale)
+	return s.post(ctx, fmt.Sprintf("/v3/content_types/%s/entries/%s/unlocalize", input.ContentTypeUID, input.UID), params, nil, nil)
+}
+
+// EntryPublishInput is used to publish or unpublish a version of an entry to
+// the environments in the given locales.
+type EntryPublishInput struct {
+	Environments []string
+	Locales      []string
+	Version      int64
+}
+
+type entryPublishRequest struct {
+	Entry struct {
+		Environments []string `json:"environments"`
+		Locales      []string `json:"locales"`
+	} `json:"entry"`
+	Version int64 `json:"version,omitempty"`
+}
+
+func (s *Stack) EntryPublish(ctx context.Context, contentTypeUID string, uid string, input EntryPublishInput) error {
+	return s.post(ctx, fmt.Sprintf("/v3/content_types/%s/entries/%s/publish", contentTypeUID, uid), url.Values{}, newEntryPublishRequest(input), nil)
+}
+
+func (s *Stack) EntryUnpublish(ctx context.Context, contentTypeUID string, uid string, input EntryPublishInput) error {
+	return s.post(ctx, fmt.Sprintf("/v3/content_types/%s/entries/%s/unpublish", contentTypeUID, uid), url.Values{}, newEntryPublishRequest(input), nil)
+}
+
+// EntryPublishDetail is a version of an entry which is published to an
+// environment in a locale. The environment is referred to by its UID.
+type EntryPublishDetail struct {
+	Environment string `json:"environment"`
+	Locale      string `json:"locale"`
+	Version     int64  `json:"version"`
+}
+
+type entryPublishDetailsResponse struct {
+	Entry struct {
+		PublishDetails []EntryPublishDetail `json:"publish_details"`
+	} `json:"entry"`
+}
+
+// EntryPublishDetails fetches the publish details of the entry in the locale
+// of the input.
+func (s *Stack) EntryPublishDetails(ctx context.Context, input *management.EntryContextInput) ([]EntryPublishDetail, error) {
+	params := url.Values{}
+	params.Set("locale", input.Locale)
+	params.Set("include_publish_details", "true")
+
+	result := &entryPublishDetailsResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/content_types/%s/entries/%s", input.ContentTypeUID, input.UID), params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Entry.PublishDetails, nil
+}
+
+func newEntryPublishRequest(input EntryPublishInput) entryPublishRequest {
+	req := entryPublishRequest{Version: input.Version}
+	req.Entry.Environments = input.Environments
+	req.Entry.Locales = input.Locales
+	return req
+}
@@ -0,0 +1,79 @@
+package contentstack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentstack-go-sdk/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryFetch(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusOK, `{"entry": {"uid": "blt1", "locale": "nl-nl", "_version": 2, "title": "Instellingen", "ACL": {}}}`)
+
+	entry, err := stack.EntryFetch(context.Background(), &management.EntryContextInput{
+		ContentTypeUID: "settings",
+		Locale:         "nl-nl",
+		UID:            "blt1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "nl-nl", entry.Locale)
+	assert.Equal(t, 2, entry.Version)
+	assert.Equal(t, map[string]interface{}{"title": "Instellingen"}, entry.Fields)
+
+	req := (*requests)[0]
+	assert.Equal(t, "/v3/content_types/settings/entries/blt1", req.Path)
+	assert.Equal(t, "locale=nl-nl", req.Query)
+}
+
+func TestEntryPublishDetails(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusOK, `{"entry": {"uid": "blt1", "locale": "nl-nl", "publish_details": [
+		{"environment": "blt2", "locale": "nl-nl", "time": "2026-01-01T00:00:00.000Z", "user": "blt3", "version": 2}
+	]}}`)
+
+	details, err := stack.EntryPublishDetails(context.Background(), &management.EntryContextInput{
+		ContentTypeUID: "settings",
+		Locale:         "nl-nl",
+		UID:            "blt1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []EntryPublishDetail{{Environment: "blt2", Locale: "nl-nl", Version: 2}}, details)
+
+	req := (*requests)[0]
+	assert.Equal(t, "/v3/content_types/settings/entries/blt1", req.Path)
+	assert.Equal(t, "include_publish_details=true&locale=nl-nl", req.Query)
+}
+
+func TestEntryUnlocalize(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusOK, `{"notice": "Entry unlocalized successfully."}`)
+
+	err := stack.EntryUnlocalize(context.Background(), &management.EntryContextInput{
+		ContentTypeUID: "settings",
+		Locale:         "nl-nl",
+		UID:            "blt1",
+	})
+	require.NoError(t, err)
+
+	req := (*requests)[0]
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.Equal(t, "/v3/content_types/settings/entries/blt1/unlocalize", req.Path)
+	assert.Equal(t, "locale=nl-nl", req.Query)
+}
+
+func TestEntryPublish(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusOK, `{"notice": "The requested action has been performed."}`)
+
+	err := stack.EntryPublish(context.Background(), "settings", "blt1", EntryPublishInput{
+		Environments: []string{"production"},
+		Locales:      []string{"en-us"},
+		Version:      2,
+	})
+	require.NoError(t, err)
+
+	req := (*requests)[0]
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.Equal(t, "/v3/content_types/settings/entries/blt1/publish", req.Path)
+	assert.JSONEq(t, `{"entry": {"environments": ["production"], "locales": ["en-us"]}, "version": 2}`, req.Body)
+}
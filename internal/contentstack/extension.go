@@ -0,0 +1,74 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Extension is an extension of the stack, either a custom field, a widget or
+// a dashboard widget. The configuration is kept as JSON.
+type Extension struct {
+	UID      string          `json:"uid"`
+	Title    string          `json:"title"`
+	Type     string          `json:"type"`
+	Src      string          `json:"src"`
+	Srcdoc   string          `json:"srcdoc"`
+	Config   json.RawMessage `json:"config"`
+	DataType string          `json:"data_type"`
+	Multiple bool            `json:"multiple"`
+	Tags     []string        `json:"tags"`
+}
+
+// ExtensionInput is used to create or update an extension. Either Src or
+// Srcdoc is set.
+type ExtensionInput struct {
+	Title    string          `json:"title"`
+	Type     string          `json:"type"`
+	Src      string          `json:"src,omitempty"`
+	Srcdoc   string          `json:"srcdoc,omitempty"`
+	Config   json.RawMessage `json:"config"`
+	DataType string          `json:"data_type,omitempty"`
+	Multiple bool            `json:"multiple"`
+	Tags     []string        `json:"tags"`
+}
+
+type extensionRequest struct {
+	Extension ExtensionInput `json:"extension"`
+}
+
+type extensionResponse struct {
+	Extension Extension `json:"extension"`
+}
+
+func (s *Stack) ExtensionCreate(ctx context.Context, input ExtensionInput) (*Extension, error) {
+	result := &extensionResponse{}
+	err := s.post(ctx, "/v3/extensions", url.Values{}, extensionRequest{Extension: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Extension, nil
+}
+
+func (s *Stack) ExtensionUpdate(ctx context.Context, uid string, input ExtensionInput) (*Extension, error) {
+	result := &extensionResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/extensions/%s", uid), url.Values{}, extensionRequest{Extension: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Extension, nil
+}
+
+func (s *Stack) ExtensionFetch(ctx context.Context, uid string) (*Extension, error) {
+	result := &extensionResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/extensions/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Extension, nil
+}
+
+func (s *Stack) ExtensionDelete(ctx context.Context, uid string) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/extensions/%s", uid), url.Values{}, nil, nil)
+}
@@ -0,0 +1,61 @@
+package contentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Label is a label of the stack, used to group content types.
+type Label struct {
+	UID          string   `json:"uid"`
+	Name         string   `json:"name"`
+	Parent       []string `json:"parent"`
+	ContentTypes []string `json:"content_types"`
+}
+
+// LabelInput is used to create or update a label.
+type LabelInput struct {
+	Name         string   `json:"name"`
+	Parent       []string `json:"parent"`
+	ContentTypes []string `json:"content_types"`
+}
+
+type labelRequest struct {
+	Label LabelInput `json:"label"`
+}
+
+type labelResponse struct {
+	Label Label `json:"label"`
+}
+
+func (s *Stack) LabelCreate(ctx context.Context, input LabelInput) (*Label, error) {
+	result := &labelResponse{}
+	err := s.post(ctx, "/v3/labels", url.Values{}, labelRequest{Label: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Label, nil
+}
+
+func (s *Stack) LabelUpdate(ctx context.Context, uid string, input LabelInput) (*Label, error) {
+	result := &labelResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/labels/%s", uid), url.Values{}, labelRequest{Label: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Label, nil
+}
+
+func (s *Stack) LabelFetch(ctx context.Context, uid string) (*Label, error) {
+	result := &labelResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/labels/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Label, nil
+}
+
+func (s *Stack) LabelDelete(ctx context.Context, uid string) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/labels/%s", uid), url.Values{}, nil, nil)
+}
@@ -0,0 +1,68 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// ManagementToken is a management token of the stack. The token value is only
+// returned when the token is created.
+type ManagementToken struct {
+	UID         string          `json:"uid"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Token       string          `json:"token"`
+	ExpiresOn   string          `json:"expires_on"`
+	Scope       json.RawMessage `json:"scope"`
+}
+
+// ManagementTokenInput is used to create or update a management token. The
+// token doesn't expire when ExpiresOn is nil, it is sent as null so the
+// expiry is removed on update.
+type ManagementTokenInput struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	ExpiresOn   *string         `json:"expires_on"`
+	Scope       json.RawMessage `json:"scope"`
+}
+
+type managementTokenRequest struct {
+	Token ManagementTokenInput `json:"token"`
+}
+
+type managementTokenResponse struct {
+	Token ManagementToken `json:"token"`
+}
+
+func (s *Stack) ManagementTokenCreate(ctx context.Context, input ManagementTokenInput) (*ManagementToken, error) {
+	result := &managementTokenResponse{}
+	err := s.post(ctx, "/v3/stacks/management_tokens", url.Values{}, managementTokenRequest{Token: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Token, nil
+}
+
+func (s *Stack) ManagementTokenUpdate(ctx context.Context, uid string, input ManagementTokenInput) (*ManagementToken, error) {
+	result := &managementTokenResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/stacks/management_tokens/%s", uid), url.Values{}, managementTokenRequest{Token: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Token, nil
+}
+
+func (s *Stack) ManagementTokenFetch(ctx context.Context, uid string) (*ManagementToken, error) {
+	result := &managementTokenResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/stacks/management_tokens/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Token, nil
+}
+
+func (s *Stack) ManagementTokenDelete(ctx context.Context, uid string) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/stacks/management_tokens/%s", uid), url.Values{}, nil, nil)
+}
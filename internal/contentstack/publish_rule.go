@@ -0,0 +1,73 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// PublishRule is a publish rule of a workflow. The approvers are kept as JSON
+// since they contain both users and roles.
+type PublishRule struct {
+	UID                       string          `json:"uid"`
+	Workflow                  string          `json:"workflow"`
+	WorkflowStage             string          `json:"workflow_stage"`
+	Environment               string          `json:"environment"`
+	ContentTypes              []string        `json:"content_types"`
+	Locales                   []string        `json:"locales"`
+	Actions                   []string        `json:"actions"`
+	Approvers                 json.RawMessage `json:"approvers"`
+	DisableApproverPublishing bool            `json:"disable_approver_publishing"`
+}
+
+// PublishRuleInput is used to create or update a publish rule.
+type PublishRuleInput struct {
+	Workflow                  string          `json:"workflow"`
+	WorkflowStage             string          `json:"workflow_stage,omitempty"`
+	Environment               string          `json:"environment"`
+	ContentTypes              []string        `json:"content_types"`
+	Locales                   []string        `json:"locales"`
+	Actions                   []string        `json:"actions"`
+	Approvers                 json.RawMessage `json:"approvers"`
+	DisableApproverPublishing bool            `json:"disable_approver_publishing"`
+}
+
+type publishRuleRequest struct {
+	PublishRule PublishRuleInput `json:"publishing_rule"`
+}
+
+type publishRuleResponse struct {
+	PublishRule PublishRule `json:"publishing_rule"`
+}
+
+func (s *Stack) PublishRuleCreate(ctx context.Context, input PublishRuleInput) (*PublishRule, error) {
+	result := &publishRuleResponse{}
+	err := s.post(ctx, "/v3/workflows/publishing_rules", url.Values{}, publishRuleRequest{PublishRule: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.PublishRule, nil
+}
+
+func (s *Stack) PublishRuleUpdate(ctx context.Context, uid string, input PublishRuleInput) (*PublishRule, error) {
+	result := &publishRuleResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/workflows/publishing_rules/%s", uid), url.Values{}, publishRuleRequest{PublishRule: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.PublishRule, nil
+}
+
+func (s *Stack) PublishRuleFetch(ctx context.Context, uid string) (*PublishRule, error) {
+	result := &publishRuleResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/workflows/publishing_rules/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.PublishRule, nil
+}
+
+func (s *Stack) PublishRuleDelete(ctx context.Context, uid string) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/workflows/publishing_rules/%s", uid), url.Values{}, nil, nil)
+}
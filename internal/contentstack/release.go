@@ -0,0 +1,97 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Release is a release of the stack. The items and the deployment status per
+// environment are kept as JSON.
+type Release struct {
+	UID         string          `json:"uid"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Locked      bool            `json:"locked"`
+	Items       json.RawMessage `json:"items"`
+	Status      json.RawMessage `json:"status"`
+}
+
+// ReleaseInput is used to create or update a release. The items are changed
+// separately.
+type ReleaseInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Locked      bool   `json:"locked"`
+}
+
+// ReleaseDeployInput is used to deploy a release to the environments in the
+// given locales.
+type ReleaseDeployInput struct {
+	Environments []string `json:"environments"`
+	Locales      []string `json:"locales"`
+}
+
+type releaseRequest struct {
+	Release ReleaseInput `json:"release"`
+}
+
+type releaseResponse struct {
+	Release Release `json:"release"`
+}
+
+type releaseItemsRequest struct {
+	Items json.RawMessage `json:"items"`
+}
+
+type releaseDeployRequest struct {
+	Release ReleaseDeployInput `json:"release"`
+}
+
+func (s *Stack) ReleaseCreate(ctx context.Context, input ReleaseInput) (*Release, error) {
+	result := &releaseResponse{}
+	err := s.post(ctx, "/v3/releases", url.Values{}, releaseRequest{Release: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Release, nil
+}
+
+func (s *Stack) ReleaseUpdate(ctx context.Context, uid string, input ReleaseInput) (*Release, error) {
+	result := &releaseResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/releases/%s", uid), url.Values{}, releaseRequest{Release: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Release, nil
+}
+
+func (s *Stack) ReleaseFetch(ctx context.Context, uid string) (*Release, error) {
+	result := &releaseResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/releases/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Release, nil
+}
+
+func (s *Stack) ReleaseDelete(ctx context.Context, uid string) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/releases/%s", uid), url.Values{}, nil, nil)
+}
+
+// ReleaseItemsAdd adds the items, given as JSON list, to the release.
+func (s *Stack) ReleaseItemsAdd(ctx context.Context, uid string, items json.RawMessage) error {
+	return s.post(ctx, fmt.Sprintf("/v3/releases/%s/items", uid), url.Values{}, releaseItemsRequest{Items: items}, nil)
+}
+
+// ReleaseItemsDelete removes the items, given as JSON list, from the release.
+func (s *Stack) ReleaseItemsDelete(ctx context.Context, uid string, items json.RawMessage) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/releases/%s/items", uid), url.Values{}, releaseItemsRequest{Items: items}, nil)
+}
+
+// ReleaseDeploy starts the deployment of the release. The deployment status
+// is part of the release.
+func (s *Stack) ReleaseDeploy(ctx context.Context, uid string, input ReleaseDeployInput) error {
+	return s.post(ctx, fmt.Sprintf("/v3/releases/%s/deploy", uid), url.Values{}, releaseDeployRequest{Release: input}, nil)
+}
@@ -0,0 +1,24 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseItemsDelete(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusOK, `{"notice": "Item(s) deleted successfully."}`)
+
+	items := json.RawMessage(`[{"uid": "blt1", "version": 1, "content_type_uid": "page", "locale": "en-us", "action": "publish"}]`)
+	err := stack.ReleaseItemsDelete(context.Background(), "blt0", items)
+	require.NoError(t, err)
+
+	req := (*requests)[0]
+	assert.Equal(t, http.MethodDelete, req.Method)
+	assert.Equal(t, "/v3/releases/blt0/items", req.Path)
+	assert.JSONEq(t, `{"items": [{"uid": "blt1", "version": 1, "content_type_uid": "page", "locale": "en-us", "action": "publish"}]}`, req.Body)
+}
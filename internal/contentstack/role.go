@@ -0,0 +1,65 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Role is a role of the stack. The rules are kept as JSON, their structure
+// depends on the module they apply to.
+type Role struct {
+	UID           string          `json:"uid"`
+	Name          string          `json:"name"`
+	Description   string          `json:"description"`
+	DeployContent bool            `json:"deploy_content"`
+	Rules         json.RawMessage `json:"rules"`
+}
+
+// RoleInput is used to create or update a role.
+type RoleInput struct {
+	Name          string          `json:"name"`
+	Description   string          `json:"description"`
+	DeployContent bool            `json:"deploy_content"`
+	Rules         json.RawMessage `json:"rules"`
+}
+
+type roleRequest struct {
+	Role RoleInput `json:"role"`
+}
+
+type roleResponse struct {
+	Role Role `json:"role"`
+}
+
+func (s *Stack) RoleCreate(ctx context.Context, input RoleInput) (*Role, error) {
+	result := &roleResponse{}
+	err := s.post(ctx, "/v3/roles", url.Values{}, roleRequest{Role: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Role, nil
+}
+
+func (s *Stack) RoleUpdate(ctx context.Context, uid string, input RoleInput) (*Role, error) {
+	result := &roleResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/roles/%s", uid), url.Values{}, roleRequest{Role: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Role, nil
+}
+
+func (s *Stack) RoleFetch(ctx context.Context, uid string) (*Role, error) {
+	result := &roleResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/roles/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Role, nil
+}
+
+func (s *Stack) RoleDelete(ctx context.Context, uid string) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/roles/%s", uid), url.Values{}, nil, nil)
+}
@@ -0,0 +1,45 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// StackSettings are the settings of the stack. Only the Live Preview settings
+// are included, these are kept as JSON.
+type StackSettings struct {
+	LivePreview json.RawMessage `json:"live_preview"`
+}
+
+// StackSettingsInput is used to update the settings of the stack. Settings
+// which are not set are left unchanged.
+type StackSettingsInput struct {
+	LivePreview json.RawMessage `json:"live_preview,omitempty"`
+}
+
+type stackSettingsRequest struct {
+	StackSettings StackSettingsInput `json:"stack_settings"`
+}
+
+type stackSettingsResponse struct {
+	StackSettings StackSettings `json:"stack_settings"`
+}
+
+func (s *Stack) StackSettingsFetch(ctx context.Context) (*StackSettings, error) {
+	result := &stackSettingsResponse{}
+	err := s.get(ctx, "/v3/stacks/settings", url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.StackSettings, nil
+}
+
+func (s *Stack) StackSettingsUpdate(ctx context.Context, input StackSettingsInput) (*StackSettings, error) {
+	result := &stackSettingsResponse{}
+	err := s.post(ctx, "/v3/stacks/settings", url.Values{}, stackSettingsRequest{StackSettings: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.StackSettings, nil
+}
@@ -0,0 +1,26 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackSettingsUpdate(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusCreated, `{"stack_settings": {"stack_variables": {}, "live_preview": {"enabled": true}}}`)
+
+	settings, err := stack.StackSettingsUpdate(context.Background(), StackSettingsInput{
+		LivePreview: json.RawMessage(`{"enabled": true}`),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"enabled": true}`, string(settings.LivePreview))
+
+	req := (*requests)[0]
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.Equal(t, "/v3/stacks/settings", req.Path)
+	assert.JSONEq(t, `{"stack_settings": {"live_preview": {"enabled": true}}}`, req.Body)
+}
@@ -0,0 +1,61 @@
+package contentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Taxonomy is a hierarchy of terms to categorise entries.
+type Taxonomy struct {
+	UID         string `json:"uid"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// TaxonomyInput is used to create or update a taxonomy. The UID can't be
+// changed.
+type TaxonomyInput struct {
+	UID         string `json:"uid,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type taxonomyRequest struct {
+	Taxonomy TaxonomyInput `json:"taxonomy"`
+}
+
+type taxonomyResponse struct {
+	Taxonomy Taxonomy `json:"taxonomy"`
+}
+
+func (s *Stack) TaxonomyCreate(ctx context.Context, input TaxonomyInput) (*Taxonomy, error) {
+	result := &taxonomyResponse{}
+	err := s.post(ctx, "/v3/taxonomies", url.Values{}, taxonomyRequest{Taxonomy: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Taxonomy, nil
+}
+
+func (s *Stack) TaxonomyUpdate(ctx context.Context, uid string, input TaxonomyInput) (*Taxonomy, error) {
+	result := &taxonomyResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/taxonomies/%s", uid), url.Values{}, taxonomyRequest{Taxonomy: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Taxonomy, nil
+}
+
+func (s *Stack) TaxonomyFetch(ctx context.Context, uid string) (*Taxonomy, error) {
+	result := &taxonomyResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/taxonomies/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Taxonomy, nil
+}
+
+func (s *Stack) TaxonomyDelete(ctx context.Context, uid string) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/taxonomies/%s", uid), url.Values{}, nil, nil)
+}
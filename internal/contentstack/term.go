@@ -0,0 +1,98 @@
+package contentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Term is a term of a taxonomy. Terms without parent are at the root of the
+// taxonomy.
+type Term struct {
+	UID       string `json:"uid"`
+	Name      string `json:"name"`
+	ParentUID string `json:"parent_uid"`
+	Order     int64  `json:"order"`
+}
+
+// TermInput is used to create or update a term. The parent and order are only
+// used when the term is created, use TermMove to change these. The term is
+// added after the existing terms when the order is zero.
+type TermInput struct {
+	UID       string `json:"uid"`
+	Name      string `json:"name"`
+	ParentUID string `json:"parent_uid,omitempty"`
+	Order     int64  `json:"order,omitempty"`
+}
+
+// TermMoveInput is used to move a term. The term is moved to the root of the
+// taxonomy when the parent is empty.
+type TermMoveInput struct {
+	ParentUID string
+	Order     int64
+}
+
+type termRequest struct {
+	Term TermInput `json:"term"`
+}
+
+type termResponse struct {
+	Term Term `json:"term"`
+}
+
+// termMoveRequest sends the parent as null to move the term to the root.
+type termMoveRequest struct {
+	Term struct {
+		ParentUID *string `json:"parent_uid"`
+		Order     int64   `json:"order,omitempty"`
+	} `json:"term"`
+}
+
+func (s *Stack) TermCreate(ctx context.Context, taxonomyUID string, input TermInput) (*Term, error) {
+	result := &termResponse{}
+	err := s.post(ctx, fmt.Sprintf("/v3/taxonomies/%s/terms", taxonomyUID), url.Values{}, termRequest{Term: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Term, nil
+}
+
+func (s *Stack) TermUpdate(ctx context.Context, taxonomyUID string, uid string, input TermInput) (*Term, error) {
+	result := &termResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/taxonomies/%s/terms/%s", taxonomyUID, uid), url.Values{}, termRequest{Term: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Term, nil
+}
+
+func (s *Stack) TermFetch(ctx context.Context, taxonomyUID string, uid string) (*Term, error) {
+	result := &termResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/taxonomies/%s/terms/%s", taxonomyUID, uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Term, nil
+}
+
+// TermDelete deletes the term including its child terms.
+func (s *Stack) TermDelete(ctx context.Context, taxonomyUID string, uid string) error {
+	params := url.Values{}
+	params.Set("force", "true")
+	return s.delete(ctx, fmt.Sprintf("/v3/taxonomies/%s/terms/%s", taxonomyUID, uid), params, nil, nil)
+}
+
+func (s *Stack) TermMove(ctx context.Context, taxonomyUID string, uid string, input TermMoveInput) (*Term, error) {
+	req := termMoveRequest{}
+	if input.ParentUID != "" {
+		req.Term.ParentUID = &input.ParentUID
+	}
+	req.Term.Order = input.Order
+
+	result := &termResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/taxonomies/%s/terms/%s/move", taxonomyUID, uid), url.Values{}, req, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Term, nil
+}
@@ -0,0 +1,27 @@
+package contentstack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTermMove(t *testing.T) {
+	stack, requests := newTestStack(t, http.StatusOK, `{"term": {"uid": "shoes", "name": "Shoes", "parent_uid": null, "order": 2}}`)
+
+	term, err := stack.TermMove(context.Background(), "categories", "shoes", TermMoveInput{Order: 2})
+	require.NoError(t, err)
+	assert.Equal(t, &Term{UID: "shoes", Name: "Shoes", Order: 2}, term)
+
+	req := (*requests)[0]
+	assert.Equal(t, http.MethodPut, req.Method)
+	assert.Equal(t, "/v3/taxonomies/categories/terms/shoes/move", req.Path)
+	assert.JSONEq(t, `{"term": {"parent_uid": null, "order": 2}}`, req.Body)
+
+	_, err = stack.TermMove(context.Background(), "categories", "shoes", TermMoveInput{ParentUID: "clothing"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"term": {"parent_uid": "clothing"}}`, (*requests)[1].Body)
+}
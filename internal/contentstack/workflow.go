@@ -0,0 +1,69 @@
+package contentstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Workflow is a workflow of the stack. The stages are kept as JSON since they
+// refer to each other by UID.
+type Workflow struct {
+	UID            string          `json:"uid"`
+	Name           string          `json:"name"`
+	Description    string          `json:"description"`
+	Enabled        bool            `json:"enabled"`
+	ContentTypes   []string        `json:"content_types"`
+	Branches       []string        `json:"branches"`
+	WorkflowStages json.RawMessage `json:"workflow_stages"`
+}
+
+// WorkflowInput is used to create or update a workflow.
+type WorkflowInput struct {
+	Name           string          `json:"name"`
+	Description    string          `json:"description"`
+	Enabled        bool            `json:"enabled"`
+	ContentTypes   []string        `json:"content_types"`
+	Branches       []string        `json:"branches,omitempty"`
+	WorkflowStages json.RawMessage `json:"workflow_stages"`
+}
+
+type workflowRequest struct {
+	Workflow WorkflowInput `json:"workflow"`
+}
+
+type workflowResponse struct {
+	Workflow Workflow `json:"workflow"`
+}
+
+func (s *Stack) WorkflowCreate(ctx context.Context, input WorkflowInput) (*Workflow, error) {
+	result := &workflowResponse{}
+	err := s.post(ctx, "/v3/workflows", url.Values{}, workflowRequest{Workflow: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Workflow, nil
+}
+
+func (s *Stack) WorkflowUpdate(ctx context.Context, uid string, input WorkflowInput) (*Workflow, error) {
+	result := &workflowResponse{}
+	err := s.put(ctx, fmt.Sprintf("/v3/workflows/%s", uid), url.Values{}, workflowRequest{Workflow: input}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Workflow, nil
+}
+
+func (s *Stack) WorkflowFetch(ctx context.Context, uid string) (*Workflow, error) {
+	result := &workflowResponse{}
+	err := s.get(ctx, fmt.Sprintf("/v3/workflows/%s", uid), url.Values{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Workflow, nil
+}
+
+func (s *Stack) WorkflowDelete(ctx context.Context, uid string) error {
+	return s.delete(ctx, fmt.Sprintf("/v3/workflows/%s", uid), url.Values{}, nil, nil)
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Field visibility rules show or hide fields of an entry based on the values
+// of other fields. The rules are defined with `field_rule` blocks and refer to
+// the fields of the content type by their UID. Fields within a group are
+// referred to by their path, e.g. `seo.meta_title`.
+
+type ContentTypeFieldRuleData struct {
+	MatchType  types.String                        `tfsdk:"match_type"`
+	Conditions []ContentTypeFieldRuleConditionData `tfsdk:"conditions"`
+	Actions    []ContentTypeFieldRuleActionData    `tfsdk:"actions"`
+}
+
+type ContentTypeFieldRuleConditionData struct {
+	OperandField types.String `tfsdk:"operand_field"`
+	Operator     types.String `tfsdk:"operator"`
+	Value        types.String `tfsdk:"value"`
+}
+
+type ContentTypeFieldRuleActionData struct {
+	Action      types.String `tfsdk:"action"`
+	TargetField types.String `tfsdk:"target_field"`
+}
+
+func contentTypeFieldRuleBlock() tfsdk.Block {
+	return tfsdk.Block{
+		NestingMode: tfsdk.BlockNestingModeList,
+		Description: "The field visibility rules of the content type. All referenced fields must be part of the schema of the content type. The field rules are left untouched when no field_rule block is set.",
+		Attributes: map[string]tfsdk.Attribute{
+			"match_type": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "Whether all or any of the conditions need to match, one of all or any.",
+				Validators: []tfsdk.AttributeValidator{
+					oneOfValidator{values: []string{"all", "any"}},
+				},
+			},
+			"conditions": {
+				Required:    true,
+				Description: "The conditions on the values of the fields.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"operand_field": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The UID of the field to check.",
+					},
+					"operator": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The operator, e.g. equals, not_equals, contains, not_contains, matches, does_not_match, starts_with, ends_with, less_than or greater_than.",
+					},
+					"value": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The value to compare the field with.",
+					},
+				}),
+			},
+			"actions": {
+				Required:    true,
+				Description: "The actions to take when the conditions match.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"action": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The action, one of show or hide.",
+						Validators: []tfsdk.AttributeValidator{
+							oneOfValidator{values: []string{"show", "hide"}},
+						},
+					},
+					"target_field": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The UID of the field to show or hide.",
+					},
+				}),
+			},
+		},
+	}
+}
+
+// contentTypeFieldRule is a field rule in the JSON representation of a
+// content type.
+type contentTypeFieldRule struct {
+	Conditions []contentTypeFieldRuleCondition `json:"conditions"`
+	MatchType  string                          `json:"match_type"`
+	Actions    []contentTypeFieldRuleAction    `json:"actions"`
+}
+
+type contentTypeFieldRuleCondition struct {
+	OperandField string      `json:"operand_field"`
+	Operator     string      `json:"operator"`
+	Value        interface{} `json:"value"`
+}
+
+type contentTypeFieldRuleAction struct {
+	Action      string `json:"action"`
+	TargetField string `json:"target_field"`
+}
+
+// newContentTypeFieldRules converts the field_rule blocks to the JSON
+// representation of the field rules. The result is nil when there are no
+// blocks, as the field rules are not managed in that case.
+func newContentTypeFieldRules(data []ContentTypeFieldRuleData) (json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(data) == 0 {
+		return nil, diags
+	}
+
+	rules := []contentTypeFieldRule{}
+	for _, d := range data {
+		rule := contentTypeFieldRule{
+			MatchType:  d.MatchType.Value,
+			Conditions: []contentTypeFieldRuleCondition{},
+			Actions:    []contentTypeFieldRuleAction{},
+		}
+		for _, c := range d.Conditions {
+			rule.Conditions = append(rule.Conditions, contentTypeFieldRuleCondition{
+				OperandField: c.OperandField.Value,
+				Operator:     c.Operator.Value,
+				Value:        c.Value.Value,
+			})
+		}
+		for _, a := range d.Actions {
+			rule.Actions = append(rule.Actions, contentTypeFieldRuleAction{
+				Action:      a.Action.Value,
+				TargetField: a.TargetField.Value,
+			})
+		}
+		rules = append(rules, rule)
+	}
+
+	result, err := json.Marshal(rules)
+	if err != nil {
+		diags.AddError("Unable to serialize field rules", err.Error())
+		return nil, diags
+	}
+	return result, diags
+}
+
+// newContentTypeFieldRuleData converts the JSON representation of the field
+// rules to the value of the field_rule blocks.
+func newContentTypeFieldRuleData(raw json.RawMessage) ([]ContentTypeFieldRuleData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := []ContentTypeFieldRuleData{}
+	if len(raw) == 0 {
+		return result, diags
+	}
+
+	rules := []contentTypeFieldRule{}
+	if err := json.Unmarshal(raw, &rules); err != nil {
+		diags.AddError("Unable to parse field rules", err.Error())
+		return result, diags
+	}
+
+	for _, rule := range rules {
+		d := ContentTypeFieldRuleData{
+			MatchType:  types.String{Value: rule.MatchType},
+			Conditions: []ContentTypeFieldRuleConditionData{},
+			Actions:    []ContentTypeFieldRuleActionData{},
+		}
+		for _, c := range rule.Conditions {
+			d.Conditions = append(d.Conditions, ContentTypeFieldRuleConditionData{
+				OperandField: types.String{Value: c.OperandField},
+				Operator:     types.String{Value: c.Operator},
+				Value:        types.String{Value: fmt.Sprint(c.Value)},
+			})
+		}
+		for _, a := range rule.Actions {
+			d.Actions = append(d.Actions, ContentTypeFieldRuleActionData{
+				Action:      types.String{Value: a.Action},
+				TargetField: types.String{Value: a.TargetField},
+			})
+		}
+		result = append(result, d)
+	}
+	return result, diags
+}
+
+// validateContentTypeFieldRules checks that all fields referenced by the
+// field rules are part of the given JSON schema.
+func validateContentTypeFieldRules(rules []ContentTypeFieldRuleData, schema json.RawMessage) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields := []contentTypeSchemaField{}
+	if err := json.Unmarshal(schema, &fields); err != nil {
+		// Invalid schemas are reported by the schema attribute itself.
+		return diags
+	}
+	paths := contentTypeFieldPaths(fields, "")
+
+	check := func(path *tftypes.AttributePath, uid types.String) {
+		if uid.Null || uid.Unknown || paths[uid.Value] {
+			return
+		}
+		diags.AddAttributeError(
+			path,
+			"Unknown field",
+			fmt.Sprintf("The field %s is not part of the schema of the content type.", uid.Value),
+		)
+	}
+
+	for i, rule := range rules {
+		path := tftypes.NewAttributePath().WithAttributeName("field_rule").WithElementKeyInt(i)
+		for j, c := range rule.Conditions {
+			check(path.WithAttributeName("conditions").WithElementKeyInt(j).WithAttributeName("operand_field"), c.OperandField)
+		}
+		for j, a := range rule.Actions {
+			check(path.WithAttributeName("actions").WithElementKeyInt(j).WithAttributeName("target_field"), a.TargetField)
+		}
+	}
+	return diags
+}
+
+// contentTypeFieldPaths returns the paths of all fields in the schema. Fields
+// within groups are joined to their parent with a dot.
+func contentTypeFieldPaths(fields []contentTypeSchemaField, prefix string) map[string]bool {
+	result := map[string]bool{}
+	for _, field := range fields {
+		path := prefix + field.UID
+		result[path] = true
+
+		for p := range contentTypeFieldPaths(field.Schema, path+".") {
+			result[p] = true
+		}
+	}
+	return result
+}
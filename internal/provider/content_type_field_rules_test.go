@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/contentstack-go-sdk/management"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func testContentTypeFieldRules(operand, target string) []ContentTypeFieldRuleData {
+	return []ContentTypeFieldRuleData{
+		{
+			MatchType: types.String{Value: "all"},
+			Conditions: []ContentTypeFieldRuleConditionData{
+				{
+					OperandField: types.String{Value: operand},
+					Operator:     types.String{Value: "equals"},
+					Value:        types.String{Value: "red"},
+				},
+			},
+			Actions: []ContentTypeFieldRuleActionData{
+				{
+					Action:      types.String{Value: "show"},
+					TargetField: types.String{Value: target},
+				},
+			},
+		},
+	}
+}
+
+func TestContentTypeFieldRulesRoundTrip(t *testing.T) {
+	rules := testContentTypeFieldRules("color", "seo.description")
+
+	raw, diags := newContentTypeFieldRules(rules)
+	assert.False(t, diags.HasError(), diags)
+	assert.JSONEq(t, `[{
+		"match_type": "all",
+		"conditions": [{"operand_field": "color", "operator": "equals", "value": "red"}],
+		"actions": [{"action": "show", "target_field": "seo.description"}]
+	}]`, string(raw))
+
+	result, diags := newContentTypeFieldRuleData(raw)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, rules, result)
+}
+
+func TestContentTypeFieldRulesUnmanaged(t *testing.T) {
+	plan := &ContentTypeData{
+		UID:        types.String{Value: "page"},
+		Title:      types.String{Value: "Page"},
+		FieldRules: []ContentTypeFieldRuleData{},
+	}
+
+	// Without field_rule blocks the field rules are left out of the input.
+	input, diags := NewContentTypeInput(plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.Nil(t, input.FieldRules)
+
+	raw, diags := newContentTypeFieldRules(testContentTypeFieldRules("color", "seo.description"))
+	assert.False(t, diags.HasError(), diags)
+	current := &contentstack.ContentType{
+		ContentType: management.ContentType{UID: "page", Title: "Page"},
+		FieldRules:  raw,
+	}
+
+	// The update sends the current field rules, so they are not reset.
+	keepUnmanagedContentType(input, current)
+	assert.JSONEq(t, string(raw), string(input.FieldRules))
+
+	state := NewContentTypeData(current)
+	MergeContentType(state, plan)
+	assert.Empty(t, state.FieldRules)
+}
+
+func TestContentTypeFieldRulesValidation(t *testing.T) {
+	schema, diags := newContentTypeSchema(testContentTypeFields())
+	assert.False(t, diags.HasError(), diags)
+
+	diags = validateContentTypeFieldRules(testContentTypeFieldRules("color", "seo.description"), schema)
+	assert.False(t, diags.HasError(), diags)
+
+	diags = validateContentTypeFieldRules(testContentTypeFieldRules("colour", "description"), schema)
+	assert.Len(t, diags, 2)
+}
@@ -0,0 +1,813 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The fields of a content type can be defined with `field` blocks as an
+// alternative to the JSON schema. The fields of groups and modular blocks are
+// defined with the nested `fields` and `blocks` attributes instead of nested
+// blocks, so lists of fields can be shared between content types as plain
+// expressions. The nesting depth is limited to maxContentTypeFieldDepth
+// levels.
+const maxContentTypeFieldDepth = 3
+
+var contentTypeFieldTypes = []string{
+	"text",
+	"rich_text",
+	"json_rte",
+	"number",
+	"boolean",
+	"date",
+	"file",
+	"link",
+	"reference",
+	"select",
+	"group",
+	"blocks",
+	"global_field",
+	"taxonomy",
+}
+
+// contentTypeFieldTypeAttributes lists the attributes which only apply to
+// specific field types.
+var contentTypeFieldTypeAttributes = map[string][]string{
+	"multiline":      {"text"},
+	"format":         {"text"},
+	"rich_text_type": {"rich_text", "json_rte"},
+	"display_type":   {"select"},
+	"choices":        {"select"},
+	"reference_to":   {"reference", "global_field"},
+	"taxonomies":     {"taxonomy"},
+	"fields":         {"group"},
+	"blocks":         {"blocks"},
+}
+
+func contentTypeFieldAttributes(depth int) map[string]tfsdk.Attribute {
+	attributes := map[string]tfsdk.Attribute{
+		"uid": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The unique ID of the field.",
+		},
+		"display_name": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The name of the field as shown to editors.",
+		},
+		"type": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The type of the field, one of " + strings.Join(contentTypeFieldTypes, ", ") + ".",
+			Validators: []tfsdk.AttributeValidator{
+				oneOfValidator{values: contentTypeFieldTypes},
+			},
+		},
+		"description": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "The help text shown to editors.",
+		},
+		"mandatory": {
+			Type:     types.BoolType,
+			Optional: true,
+		},
+		"multiple": {
+			Type:     types.BoolType,
+			Optional: true,
+		},
+		"unique": {
+			Type:     types.BoolType,
+			Optional: true,
+		},
+		"non_localizable": {
+			Type:     types.BoolType,
+			Optional: true,
+		},
+		"multiline": {
+			Type:        types.BoolType,
+			Optional:    true,
+			Description: "Whether the text field allows multiple lines. Only for `text` fields.",
+		},
+		"format": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "The regular expression the value must match. Only for `text` fields.",
+		},
+		"rich_text_type": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "The editor toolbar, one of basic, advanced or custom. Defaults to advanced. Only for `rich_text` and `json_rte` fields.",
+			Validators: []tfsdk.AttributeValidator{
+				oneOfValidator{values: []string{"basic", "advanced", "custom"}},
+			},
+		},
+		"display_type": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "How the choices are shown, one of dropdown, radio or checkbox. Defaults to dropdown. Only for `select` fields.",
+			Validators: []tfsdk.AttributeValidator{
+				oneOfValidator{values: []string{"dropdown", "radio", "checkbox"}},
+			},
+		},
+		"choices": {
+			Type:        types.ListType{ElemType: types.StringType},
+			Optional:    true,
+			Description: "The values to choose from. Only for `select` fields.",
+		},
+		"reference_to": {
+			Type:        types.ListType{ElemType: types.StringType},
+			Optional:    true,
+			Description: "The UIDs of the referenced content types for `reference` fields, or the UID of the global field for `global_field` fields.",
+		},
+		"taxonomies": {
+			Type:        types.ListType{ElemType: types.StringType},
+			Optional:    true,
+			Description: "The UIDs of the taxonomies of which terms can be selected. Only for `taxonomy` fields.",
+		},
+	}
+
+	if depth < maxContentTypeFieldDepth {
+		attributes["fields"] = tfsdk.Attribute{
+			Optional:    true,
+			Description: "The fields of the group. Only for `group` fields.",
+			Attributes:  tfsdk.ListNestedAttributes(contentTypeFieldAttributes(depth + 1)),
+		}
+		attributes["blocks"] = tfsdk.Attribute{
+			Optional:    true,
+			Description: "The blocks to choose from. Only for `blocks` fields.",
+			Attributes:  tfsdk.ListNestedAttributes(contentTypeBlockAttributes(depth + 1)),
+		}
+	}
+	return attributes
+}
+
+func contentTypeBlockAttributes(depth int) map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"uid": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The unique ID of the block.",
+		},
+		"title": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The title of the block.",
+		},
+		"fields": {
+			Required:    true,
+			Description: "The fields of the block.",
+			Attributes:  tfsdk.ListNestedAttributes(contentTypeFieldAttributes(depth)),
+		},
+	}
+}
+
+func contentTypeFieldObjectType(depth int) types.ObjectType {
+	t := tfsdk.ListNestedAttributes(contentTypeFieldAttributes(depth)).AttributeType()
+	return t.(types.ListType).ElemType.(types.ObjectType)
+}
+
+func contentTypeBlockObjectType(depth int) types.ObjectType {
+	t := tfsdk.ListNestedAttributes(contentTypeBlockAttributes(depth)).AttributeType()
+	return t.(types.ListType).ElemType.(types.ObjectType)
+}
+
+// contentTypeSchemaField is a field in the JSON schema of a content type.
+type contentTypeSchemaField struct {
+	DataType       string                   `json:"data_type"`
+	DisplayName    string                   `json:"display_name"`
+	UID            string                   `json:"uid"`
+	DisplayType    string                   `json:"display_type,omitempty"`
+	Enum           *contentTypeSchemaEnum   `json:"enum,omitempty"`
+	ExtensionUID   string                   `json:"extension_uid,omitempty"`
+	FieldMetadata  contentTypeFieldMetadata `json:"field_metadata"`
+	Format         string                   `json:"format,omitempty"`
+	ReferenceTo    json.RawMessage          `json:"reference_to,omitempty"`
+	Schema         []contentTypeSchemaField `json:"schema,omitempty"`
+	Blocks         []contentTypeSchemaBlock `json:"blocks,omitempty"`
+	Taxonomies     []contentTypeTaxonomy    `json:"taxonomies,omitempty"`
+	Mandatory      bool                     `json:"mandatory"`
+	Multiple       bool                     `json:"multiple"`
+	Unique         bool                     `json:"unique"`
+	NonLocalizable bool                     `json:"non_localizable"`
+}
+
+type contentTypeFieldMetadata struct {
+	Description             string `json:"description,omitempty"`
+	Multiline               bool   `json:"multiline,omitempty"`
+	Markdown                bool   `json:"markdown,omitempty"`
+	AllowRichText           bool   `json:"allow_rich_text,omitempty"`
+	AllowJSONRTE            bool   `json:"allow_json_rte,omitempty"`
+	RichTextType            string `json:"rich_text_type,omitempty"`
+	RefMultiple             bool   `json:"ref_multiple,omitempty"`
+	RefMultipleContentTypes bool   `json:"ref_multiple_content_types,omitempty"`
+}
+
+type contentTypeSchemaEnum struct {
+	Advanced bool                      `json:"advanced"`
+	Choices  []contentTypeSchemaChoice `json:"choices"`
+}
+
+type contentTypeSchemaChoice struct {
+	Value interface{} `json:"value"`
+}
+
+type contentTypeTaxonomy struct {
+	TaxonomyUID string `json:"taxonomy_uid"`
+}
+
+type contentTypeSchemaBlock struct {
+	UID    string                   `json:"uid"`
+	Title  string                   `json:"title"`
+	Schema []contentTypeSchemaField `json:"schema"`
+}
+
+// newContentTypeSchema converts the field blocks to the JSON schema of the
+// content type.
+func newContentTypeSchema(fields types.List) (json.RawMessage, diag.Diagnostics) {
+	path := tftypes.NewAttributePath().WithAttributeName("field")
+	schema, diags := newContentTypeSchemaFields(fields, path)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	data, err := json.Marshal(schema)
+	if err != nil {
+		diags.AddError("Unable to serialize schema", err.Error())
+		return nil, diags
+	}
+	return data, diags
+}
+
+func newContentTypeSchemaFields(fields types.List, path *tftypes.AttributePath) ([]contentTypeSchemaField, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := []contentTypeSchemaField{}
+	for i, elem := range fields.Elems {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.Null || obj.Unknown {
+			continue
+		}
+
+		field, d := newContentTypeSchemaField(obj, path.WithElementKeyInt(i))
+		diags.Append(d...)
+		result = append(result, field)
+	}
+	return result, diags
+}
+
+func newContentTypeSchemaField(obj types.Object, path *tftypes.AttributePath) (contentTypeSchemaField, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fieldType := objectString(obj, "type")
+	field := contentTypeSchemaField{
+		UID:            objectString(obj, "uid"),
+		DisplayName:    objectString(obj, "display_name"),
+		Mandatory:      objectBool(obj, "mandatory"),
+		Multiple:       objectBool(obj, "multiple"),
+		Unique:         objectBool(obj, "unique"),
+		NonLocalizable: objectBool(obj, "non_localizable"),
+		FieldMetadata: contentTypeFieldMetadata{
+			Description: objectString(obj, "description"),
+		},
+	}
+
+	if objectUnknown(obj, "type") {
+		return field, diags
+	}
+
+	names := make([]string, 0, len(contentTypeFieldTypeAttributes))
+	for name := range contentTypeFieldTypeAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fieldTypes := contentTypeFieldTypeAttributes[name]
+		if objectNull(obj, name) || stringInSlice(fieldType, fieldTypes) {
+			continue
+		}
+		diags.AddAttributeError(
+			path.WithAttributeName(name),
+			"Unsupported attribute",
+			fmt.Sprintf("The %s attribute is only supported for fields of type %s.", name, strings.Join(fieldTypes, ", ")),
+		)
+	}
+
+	switch fieldType {
+	case "text":
+		field.DataType = "text"
+		field.Format = objectString(obj, "format")
+		field.FieldMetadata.Multiline = objectBool(obj, "multiline")
+
+	case "rich_text":
+		field.DataType = "text"
+		field.FieldMetadata.AllowRichText = true
+		field.FieldMetadata.RichTextType = stringWithDefault(objectString(obj, "rich_text_type"), "advanced")
+
+	case "json_rte":
+		field.DataType = "json"
+		field.FieldMetadata.AllowJSONRTE = true
+		field.FieldMetadata.RichTextType = stringWithDefault(objectString(obj, "rich_text_type"), "advanced")
+
+	case "number", "boolean", "file", "link":
+		field.DataType = fieldType
+
+	case "date":
+		field.DataType = "isodate"
+
+	case "select":
+		field.DataType = "text"
+		field.DisplayType = stringWithDefault(objectString(obj, "display_type"), "dropdown")
+		field.Enum = &contentTypeSchemaEnum{
+			Choices: []contentTypeSchemaChoice{},
+		}
+		for _, choice := range objectStrings(obj, "choices") {
+			field.Enum.Choices = append(field.Enum.Choices, contentTypeSchemaChoice{Value: choice})
+		}
+		if objectNull(obj, "choices") {
+			diags.AddAttributeError(
+				path.WithAttributeName("choices"),
+				"Missing choices",
+				"The choices attribute is required for select fields.",
+			)
+		}
+
+	case "reference":
+		references := objectStrings(obj, "reference_to")
+		field.DataType = "reference"
+		field.ReferenceTo, _ = json.Marshal(references)
+		field.FieldMetadata.RefMultiple = field.Multiple
+		field.FieldMetadata.RefMultipleContentTypes = len(references) > 1
+		if objectNull(obj, "reference_to") {
+			diags.AddAttributeError(
+				path.WithAttributeName("reference_to"),
+				"Missing reference_to",
+				"The reference_to attribute is required for reference fields.",
+			)
+		}
+
+	case "global_field":
+		references := objectStrings(obj, "reference_to")
+		field.DataType = "global_field"
+		if len(references) > 0 {
+			field.ReferenceTo, _ = json.Marshal(references[0])
+		}
+		if !objectUnknown(obj, "reference_to") && len(references) != 1 {
+			diags.AddAttributeError(
+				path.WithAttributeName("reference_to"),
+				"Invalid reference_to",
+				"The reference_to attribute should contain exactly one global field UID for global_field fields.",
+			)
+		}
+
+	case "taxonomy":
+		field.DataType = "taxonomy"
+		field.Multiple = true
+		field.Taxonomies = []contentTypeTaxonomy{}
+		for _, uid := range objectStrings(obj, "taxonomies") {
+			field.Taxonomies = append(field.Taxonomies, contentTypeTaxonomy{TaxonomyUID: uid})
+		}
+		if objectNull(obj, "taxonomies") {
+			diags.AddAttributeError(
+				path.WithAttributeName("taxonomies"),
+				"Missing taxonomies",
+				"The taxonomies attribute is required for taxonomy fields.",
+			)
+		}
+
+	case "group":
+		field.DataType = "group"
+		fields, _ := obj.Attrs["fields"].(types.List)
+		schema, d := newContentTypeSchemaFields(fields, path.WithAttributeName("fields"))
+		diags.Append(d...)
+		field.Schema = schema
+		if objectNull(obj, "fields") {
+			diags.AddAttributeError(
+				path.WithAttributeName("fields"),
+				"Missing fields",
+				"The fields attribute is required for group fields.",
+			)
+		}
+
+	case "blocks":
+		field.DataType = "blocks"
+		field.Multiple = true
+		blocks, _ := obj.Attrs["blocks"].(types.List)
+		for i, elem := range blocks.Elems {
+			block, ok := elem.(types.Object)
+			if !ok || block.Null || block.Unknown {
+				continue
+			}
+
+			blockPath := path.WithAttributeName("blocks").WithElementKeyInt(i)
+			fields, _ := block.Attrs["fields"].(types.List)
+			schema, d := newContentTypeSchemaFields(fields, blockPath.WithAttributeName("fields"))
+			diags.Append(d...)
+
+			field.Blocks = append(field.Blocks, contentTypeSchemaBlock{
+				UID:    objectString(block, "uid"),
+				Title:  objectString(block, "title"),
+				Schema: schema,
+			})
+		}
+		if objectNull(obj, "blocks") {
+			diags.AddAttributeError(
+				path.WithAttributeName("blocks"),
+				"Missing blocks",
+				"The blocks attribute is required for blocks fields.",
+			)
+		}
+	}
+
+	return field, diags
+}
+
+// newContentTypeFieldList converts the JSON schema of a content type to the
+// value of the field blocks. The prior value is used to keep explicitly
+// configured empty values, since these are not returned by Contentstack.
+func newContentTypeFieldList(schema json.RawMessage, prior types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fields := []contentTypeSchemaField{}
+	if len(schema) > 0 {
+		if err := json.Unmarshal(schema, &fields); err != nil {
+			diags.AddError("Unable to parse schema", err.Error())
+			return prior, diags
+		}
+	}
+
+	return newContentTypeFieldListValue(fields, 1, prior)
+}
+
+func newContentTypeFieldListValue(fields []contentTypeSchemaField, depth int, prior types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := types.List{
+		ElemType: contentTypeFieldObjectType(depth),
+		Elems:    []attr.Value{},
+	}
+
+	for _, field := range fields {
+		value, d := newContentTypeFieldValue(field, depth, findObjectByUID(prior, field.UID))
+		diags.Append(d...)
+		if d.HasError() {
+			continue
+		}
+		result.Elems = append(result.Elems, value)
+	}
+	return result, diags
+}
+
+func newContentTypeFieldValue(field contentTypeSchemaField, depth int, prior types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fieldType, ok := contentTypeFieldType(field)
+	if !ok {
+		diags.AddError(
+			"Unsupported field",
+			fmt.Sprintf(
+				"The field %s with data type %s can't be represented as a field block. "+
+					"Use the schema attribute to manage this content type instead.",
+				field.UID, field.DataType),
+		)
+		return types.Object{}, diags
+	}
+
+	attrs := map[string]attr.Value{
+		"uid":             types.String{Value: field.UID},
+		"display_name":    types.String{Value: field.DisplayName},
+		"type":            types.String{Value: fieldType},
+		"description":     optionalString(field.FieldMetadata.Description, prior, "description"),
+		"mandatory":       optionalBool(field.Mandatory, prior, "mandatory"),
+		"multiple":        optionalBool(field.Multiple, prior, "multiple"),
+		"unique":          optionalBool(field.Unique, prior, "unique"),
+		"non_localizable": optionalBool(field.NonLocalizable, prior, "non_localizable"),
+		"multiline":       optionalBool(field.FieldMetadata.Multiline, prior, "multiline"),
+		"format":          optionalString(field.Format, prior, "format"),
+		"rich_text_type":  types.String{Null: true},
+		"display_type":    types.String{Null: true},
+		"choices":         types.List{Null: true, ElemType: types.StringType},
+		"reference_to":    types.List{Null: true, ElemType: types.StringType},
+		"taxonomies":      types.List{Null: true, ElemType: types.StringType},
+	}
+
+	switch fieldType {
+	case "rich_text", "json_rte":
+		attrs["rich_text_type"] = optionalStringWithDefault(field.FieldMetadata.RichTextType, "advanced", prior, "rich_text_type")
+
+	case "select":
+		attrs["display_type"] = optionalStringWithDefault(field.DisplayType, "dropdown", prior, "display_type")
+		choices := []string{}
+		if field.Enum != nil {
+			for _, choice := range field.Enum.Choices {
+				choices = append(choices, fmt.Sprint(choice.Value))
+			}
+		}
+		attrs["choices"] = stringListValue(choices)
+
+	case "reference", "global_field":
+		var references []string
+		if err := json.Unmarshal(field.ReferenceTo, &references); err != nil {
+			var reference string
+			if err := json.Unmarshal(field.ReferenceTo, &reference); err == nil {
+				references = []string{reference}
+			}
+		}
+		attrs["reference_to"] = stringListValue(references)
+
+	case "taxonomy":
+		taxonomies := []string{}
+		for _, taxonomy := range field.Taxonomies {
+			taxonomies = append(taxonomies, taxonomy.TaxonomyUID)
+		}
+		attrs["taxonomies"] = stringListValue(taxonomies)
+		// Taxonomy fields are always multiple, so only keep the value when
+		// it was set explicitly.
+		attrs["multiple"] = priorOrNull(prior, "multiple", types.Bool{Null: true})
+
+	case "blocks":
+		// Modular blocks are always multiple, so only keep the value when
+		// it was set explicitly.
+		attrs["multiple"] = priorOrNull(prior, "multiple", types.Bool{Null: true})
+	}
+
+	if depth < maxContentTypeFieldDepth {
+		attrs["fields"] = types.List{Null: true, ElemType: contentTypeFieldObjectType(depth + 1)}
+		attrs["blocks"] = types.List{Null: true, ElemType: contentTypeBlockObjectType(depth + 1)}
+
+		switch fieldType {
+		case "group":
+			priorFields, _ := prior.Attrs["fields"].(types.List)
+			fields, d := newContentTypeFieldListValue(field.Schema, depth+1, priorFields)
+			diags.Append(d...)
+			attrs["fields"] = fields
+
+		case "blocks":
+			priorBlocks, _ := prior.Attrs["blocks"].(types.List)
+			blocks := types.List{
+				ElemType: contentTypeBlockObjectType(depth + 1),
+				Elems:    []attr.Value{},
+			}
+			for _, block := range field.Blocks {
+				priorBlock := findObjectByUID(priorBlocks, block.UID)
+				priorFields, _ := priorBlock.Attrs["fields"].(types.List)
+				fields, d := newContentTypeFieldListValue(block.Schema, depth+1, priorFields)
+				diags.Append(d...)
+
+				blocks.Elems = append(blocks.Elems, types.Object{
+					AttrTypes: contentTypeBlockObjectType(depth + 1).AttrTypes,
+					Attrs: map[string]attr.Value{
+						"uid":    types.String{Value: block.UID},
+						"title":  types.String{Value: block.Title},
+						"fields": fields,
+					},
+				})
+			}
+			attrs["blocks"] = blocks
+		}
+	} else if fieldType == "group" || fieldType == "blocks" {
+		diags.AddError(
+			"Unsupported field",
+			fmt.Sprintf(
+				"The field %s is nested more than %d levels deep, which is not supported by field blocks. "+
+					"Use the schema attribute to manage this content type instead.",
+				field.UID, maxContentTypeFieldDepth),
+		)
+	}
+
+	return types.Object{
+		AttrTypes: contentTypeFieldObjectType(depth).AttrTypes,
+		Attrs:     attrs,
+	}, diags
+}
+
+// contentTypeFieldType returns the field block type for the given field of
+// the JSON schema. Returns false when the field is not supported.
+func contentTypeFieldType(field contentTypeSchemaField) (string, bool) {
+	if field.ExtensionUID != "" {
+		return "", false
+	}
+
+	switch field.DataType {
+	case "text":
+		switch {
+		case field.FieldMetadata.AllowRichText:
+			return "rich_text", true
+		case field.FieldMetadata.Markdown:
+			return "", false
+		case field.Enum != nil:
+			return "select", true
+		}
+		return "text", true
+	case "json":
+		if field.FieldMetadata.AllowJSONRTE {
+			return "json_rte", true
+		}
+		return "", false
+	case "isodate":
+		return "date", true
+	case "number", "boolean", "file", "link", "reference", "group", "blocks", "global_field", "taxonomy":
+		return field.DataType, true
+	}
+	return "", false
+}
+
+// contentTypeFieldDataTypes returns the data type of all fields in the
+// schema by their path. Fields within groups and blocks are joined to their
+// parent with a dot.
+func contentTypeFieldDataTypes(fields []contentTypeSchemaField, prefix string) map[string]string {
+	result := map[string]string{}
+	for _, field := range fields {
+		path := prefix + field.UID
+		result[path] = field.DataType
+
+		for p, dataType := range contentTypeFieldDataTypes(field.Schema, path+".") {
+			result[p] = dataType
+		}
+		for _, block := range field.Blocks {
+			for p, dataType := range contentTypeFieldDataTypes(block.Schema, path+"."+block.UID+".") {
+				result[p] = dataType
+			}
+		}
+	}
+	return result
+}
+
+// contentTypeDestructiveChanges compares two JSON schemas and returns a
+// description of every field which is removed or of which the data type
+// changes, since these changes delete the data of the field in all entries.
+func contentTypeDestructiveChanges(prior, planned json.RawMessage) ([]string, error) {
+	priorFields := []contentTypeSchemaField{}
+	if len(prior) > 0 {
+		if err := json.Unmarshal(prior, &priorFields); err != nil {
+			return nil, err
+		}
+	}
+
+	plannedFields := []contentTypeSchemaField{}
+	if len(planned) > 0 {
+		if err := json.Unmarshal(planned, &plannedFields); err != nil {
+			return nil, err
+		}
+	}
+
+	before := contentTypeFieldDataTypes(priorFields, "")
+	after := contentTypeFieldDataTypes(plannedFields, "")
+
+	changes := []string{}
+	for path, dataType := range before {
+		newDataType, ok := after[path]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("%s is removed", path))
+		case newDataType != dataType:
+			changes = append(changes, fmt.Sprintf("%s changes from %s to %s", path, dataType, newDataType))
+		}
+	}
+	sort.Strings(changes)
+	return changes, nil
+}
+
+// hasUnknownElems reports whether any value of the fields, including the
+// values of nested fields and blocks, is not known yet.
+func hasUnknownElems(fields types.List) bool {
+	for _, elem := range fields.Elems {
+		if hasUnknownValue(elem) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasUnknownValue(value attr.Value) bool {
+	if value == nil {
+		return false
+	}
+	if value.IsUnknown() {
+		return true
+	}
+
+	switch v := value.(type) {
+	case types.List:
+		return hasUnknownElems(v)
+	case types.Object:
+		for _, elem := range v.Attrs {
+			if hasUnknownValue(elem) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func findObjectByUID(list types.List, uid string) types.Object {
+	for _, elem := range list.Elems {
+		if obj, ok := elem.(types.Object); ok && objectString(obj, "uid") == uid {
+			return obj
+		}
+	}
+	return types.Object{}
+}
+
+func objectString(obj types.Object, key string) string {
+	v, _ := obj.Attrs[key].(types.String)
+	return v.Value
+}
+
+func objectBool(obj types.Object, key string) bool {
+	v, _ := obj.Attrs[key].(types.Bool)
+	return v.Value
+}
+
+func objectStrings(obj types.Object, key string) []string {
+	v, _ := obj.Attrs[key].(types.List)
+	result := []string{}
+	for _, elem := range v.Elems {
+		if s, ok := elem.(types.String); ok && !s.Null && !s.Unknown {
+			result = append(result, s.Value)
+		}
+	}
+	return result
+}
+
+func objectNull(obj types.Object, key string) bool {
+	v, ok := obj.Attrs[key]
+	return !ok || v == nil || v.IsNull()
+}
+
+func objectUnknown(obj types.Object, key string) bool {
+	v, ok := obj.Attrs[key]
+	return ok && v != nil && v.IsUnknown()
+}
+
+func priorOrNull(prior types.Object, key string, null attr.Value) attr.Value {
+	if v, ok := prior.Attrs[key]; ok && v != nil && !v.IsNull() && !v.IsUnknown() {
+		return v
+	}
+	return null
+}
+
+// optionalString returns the value as string, or null when the value is empty
+// and wasn't set explicitly before.
+func optionalString(value string, prior types.Object, key string) attr.Value {
+	return optionalStringWithDefault(value, "", prior, key)
+}
+
+func optionalStringWithDefault(value, defaultValue string, prior types.Object, key string) attr.Value {
+	if value != defaultValue && value != "" {
+		return types.String{Value: value}
+	}
+	if p, ok := prior.Attrs[key].(types.String); ok && !p.Null && !p.Unknown && stringWithDefault(p.Value, defaultValue) == stringWithDefault(value, defaultValue) {
+		return p
+	}
+	return types.String{Null: true}
+}
+
+// optionalBool returns the value as bool, or null when the value is false and
+// wasn't set explicitly before.
+func optionalBool(value bool, prior types.Object, key string) attr.Value {
+	if value {
+		return types.Bool{Value: true}
+	}
+	if p, ok := prior.Attrs[key].(types.Bool); ok && !p.Null && !p.Unknown && !p.Value {
+		return p
+	}
+	return types.Bool{Null: true}
+}
+
+func stringListValue(values []string) types.List {
+	result := types.List{
+		ElemType: types.StringType,
+		Elems:    []attr.Value{},
+	}
+	for _, value := range values {
+		result.Elems = append(result.Elems, types.String{Value: value})
+	}
+	return result
+}
+
+func stringWithDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+func stringInSlice(value string, values []string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
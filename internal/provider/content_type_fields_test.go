@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func testContentTypeField(depth int, attrs map[string]attr.Value) types.Object {
+	objectType := contentTypeFieldObjectType(depth)
+	values := map[string]attr.Value{}
+	for name, t := range objectType.AttrTypes {
+		switch t.(type) {
+		case types.ListType:
+			values[name] = types.List{Null: true, ElemType: t.(types.ListType).ElemType}
+		case types.ObjectType:
+			values[name] = types.Object{Null: true, AttrTypes: t.(types.ObjectType).AttrTypes}
+		default:
+			v, _ := t.ValueFromTerraform(context.Background(), tftypes.NewValue(t.TerraformType(context.Background()), nil))
+			values[name] = v
+		}
+	}
+	for name, v := range attrs {
+		values[name] = v
+	}
+	return types.Object{AttrTypes: objectType.AttrTypes, Attrs: values}
+}
+
+func testContentTypeFields() types.List {
+	return types.List{
+		ElemType: contentTypeFieldObjectType(1),
+		Elems: []attr.Value{
+			testContentTypeField(1, map[string]attr.Value{
+				"uid":          types.String{Value: "title"},
+				"display_name": types.String{Value: "Title"},
+				"type":         types.String{Value: "text"},
+				"mandatory":    types.Bool{Value: true},
+				"unique":       types.Bool{Value: false},
+			}),
+			testContentTypeField(1, map[string]attr.Value{
+				"uid":          types.String{Value: "color"},
+				"display_name": types.String{Value: "Color"},
+				"type":         types.String{Value: "select"},
+				"choices":      stringListValue([]string{"red", "green"}),
+			}),
+			testContentTypeField(1, map[string]attr.Value{
+				"uid":          types.String{Value: "seo"},
+				"display_name": types.String{Value: "SEO"},
+				"type":         types.String{Value: "group"},
+				"fields": types.List{
+					ElemType: contentTypeFieldObjectType(2),
+					Elems: []attr.Value{
+						testContentTypeField(2, map[string]attr.Value{
+							"uid":            types.String{Value: "description"},
+							"display_name":   types.String{Value: "Description"},
+							"type":           types.String{Value: "rich_text"},
+							"rich_text_type": types.String{Value: "basic"},
+						}),
+					},
+				},
+			}),
+			testContentTypeField(1, map[string]attr.Value{
+				"uid":          types.String{Value: "related"},
+				"display_name": types.String{Value: "Related"},
+				"type":         types.String{Value: "reference"},
+				"multiple":     types.Bool{Value: true},
+				"reference_to": stringListValue([]string{"page", "article"}),
+			}),
+			testContentTypeField(1, map[string]attr.Value{
+				"uid":          types.String{Value: "categories"},
+				"display_name": types.String{Value: "Categories"},
+				"type":         types.String{Value: "taxonomy"},
+				"taxonomies":   stringListValue([]string{"product_categories"}),
+			}),
+		},
+	}
+}
+
+func TestContentTypeFieldsRoundTrip(t *testing.T) {
+	fields := testContentTypeFields()
+
+	schema, diags := newContentTypeSchema(fields)
+	assert.False(t, diags.HasError(), diags)
+
+	// Contentstack adds default values to the schema, these should not
+	// result in differences.
+	var raw []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(schema, &raw))
+	raw[0]["indexed"] = false
+	raw[0]["field_metadata"] = map[string]interface{}{"_default": true, "version": 3}
+	remote, _ := json.Marshal(raw)
+
+	result, diags := newContentTypeFieldList(remote, fields)
+	assert.False(t, diags.HasError(), diags)
+	assert.True(t, fields.Equal(result))
+}
+
+func TestContentTypeFieldsSchema(t *testing.T) {
+	schema, diags := newContentTypeSchema(testContentTypeFields())
+	assert.False(t, diags.HasError(), diags)
+
+	var result []contentTypeSchemaField
+	assert.NoError(t, json.Unmarshal(schema, &result))
+	assert.Len(t, result, 5)
+	assert.Equal(t, "text", result[1].DataType)
+	assert.Equal(t, "dropdown", result[1].DisplayType)
+	assert.Len(t, result[1].Enum.Choices, 2)
+	assert.Equal(t, "text", result[2].Schema[0].DataType)
+	assert.True(t, result[2].Schema[0].FieldMetadata.AllowRichText)
+	assert.Equal(t, `["page","article"]`, string(result[3].ReferenceTo))
+	assert.True(t, result[3].FieldMetadata.RefMultiple)
+	assert.True(t, result[3].FieldMetadata.RefMultipleContentTypes)
+	assert.Equal(t, "taxonomy", result[4].DataType)
+	assert.True(t, result[4].Multiple)
+	assert.Equal(t, "product_categories", result[4].Taxonomies[0].TaxonomyUID)
+}
+
+func TestContentTypeFieldsValidation(t *testing.T) {
+	fields := types.List{
+		ElemType: contentTypeFieldObjectType(1),
+		Elems: []attr.Value{
+			testContentTypeField(1, map[string]attr.Value{
+				"uid":          types.String{Value: "color"},
+				"display_name": types.String{Value: "Color"},
+				"type":         types.String{Value: "select"},
+			}),
+			testContentTypeField(1, map[string]attr.Value{
+				"uid":          types.String{Value: "title"},
+				"display_name": types.String{Value: "Title"},
+				"type":         types.String{Value: "number"},
+				"multiline":    types.Bool{Value: true},
+			}),
+		},
+	}
+
+	_, diags := newContentTypeSchema(fields)
+	assert.Len(t, diags, 2)
+}
+
+func TestContentTypeFieldsUnsupported(t *testing.T) {
+	schema := `[{"uid": "color", "display_name": "Color", "data_type": "text", "extension_uid": "abc"}]`
+
+	_, diags := newContentTypeFieldList(json.RawMessage(schema), types.List{})
+	assert.True(t, diags.HasError())
+}
+
+func TestContentTypeDataState(t *testing.T) {
+	ctx := context.Background()
+	schema, diags := resourceContentTypeType{}.GetSchema(ctx)
+	assert.False(t, diags.HasError())
+
+	data := ContentTypeData{
+		UID:    types.String{Value: "page"},
+		Title:  types.String{Value: "Page"},
+		Schema: JSONValue{Null: true},
+		Fields: testContentTypeFields(),
+	}
+
+	state := tfsdk.State{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.TerraformType(ctx), nil),
+	}
+	diags = state.Set(ctx, &data)
+	assert.False(t, diags.HasError(), diags)
+
+	var result ContentTypeData
+	diags = state.Get(ctx, &result)
+	assert.False(t, diags.HasError(), diags)
+	assert.True(t, data.Fields.Equal(result.Fields))
+}
+
+func TestContentTypeDestructiveChanges(t *testing.T) {
+	prior := `[
+		{"uid": "title", "data_type": "text"},
+		{"uid": "count", "data_type": "number"},
+		{"uid": "seo", "data_type": "group", "schema": [
+			{"uid": "meta_title", "data_type": "text"},
+			{"uid": "meta_description", "data_type": "text"}
+		]},
+		{"uid": "sections", "data_type": "blocks", "blocks": [
+			{"uid": "hero", "schema": [{"uid": "image", "data_type": "file"}]}
+		]}
+	]`
+	planned := `[
+		{"uid": "title", "data_type": "text"},
+		{"uid": "count", "data_type": "text"},
+		{"uid": "seo", "data_type": "group", "schema": [
+			{"uid": "meta_title", "data_type": "text"}
+		]},
+		{"uid": "sections", "data_type": "blocks", "blocks": []},
+		{"uid": "summary", "data_type": "text"}
+	]`
+
+	changes, err := contentTypeDestructiveChanges(json.RawMessage(prior), json.RawMessage(planned))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"count changes from number to text",
+		"sections.hero.image is removed",
+		"seo.meta_description is removed",
+	}, changes)
+
+	changes, err = contentTypeDestructiveChanges(json.RawMessage(prior), json.RawMessage(prior))
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestHasUnknownElems(t *testing.T) {
+	fields := testContentTypeFields()
+	assert.False(t, hasUnknownElems(fields))
+
+	// Unknown values are found in any nested list, such as the fields of
+	// the blocks of a modular blocks field.
+	blockFields := types.List{
+		ElemType: contentTypeFieldObjectType(2),
+		Elems: []attr.Value{
+			testContentTypeField(2, map[string]attr.Value{
+				"uid":          types.String{Unknown: true},
+				"display_name": types.String{Value: "Body"},
+				"type":         types.String{Value: "text"},
+			}),
+		},
+	}
+	blocks := types.List{
+		ElemType: contentTypeBlockObjectType(2),
+		Elems: []attr.Value{
+			types.Object{
+				AttrTypes: contentTypeBlockObjectType(2).AttrTypes,
+				Attrs: map[string]attr.Value{
+					"uid":    types.String{Value: "text"},
+					"title":  types.String{Value: "Text"},
+					"fields": blockFields,
+				},
+			},
+		},
+	}
+	fields.Elems = append(fields.Elems, testContentTypeField(1, map[string]attr.Value{
+		"uid":          types.String{Value: "sections"},
+		"display_name": types.String{Value: "Sections"},
+		"type":         types.String{Value: "blocks"},
+		"blocks":       blocks,
+	}))
+	assert.True(t, hasUnknownElems(fields))
+
+	fields = testContentTypeFields()
+	fields.Elems[1] = testContentTypeField(1, map[string]attr.Value{
+		"uid":          types.String{Value: "color"},
+		"display_name": types.String{Value: "Color"},
+		"type":         types.String{Value: "select"},
+		"choices":      types.List{Unknown: true, ElemType: types.StringType},
+	})
+	assert.True(t, hasUnknownElems(fields))
+}
@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"github.com/labd/contentstack-go-sdk/management"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -52,3 +55,36 @@ func copyHttpBasicPasswords(wd []management.WebhookDestination, data WebhookDest
 	}
 	return cd, nil
 }
+
+// waitFor calls check every interval until it reports that the operation is
+// done, returns an error or the timeout expires.
+func waitFor(ctx context.Context, interval, timeout time.Duration, check func() (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout after %s", timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// newStringList converts the strings to a list of string values. The result is
+// never nil.
+func newStringList(values []string) []types.String {
+	result := []types.String{}
+	for _, value := range values {
+		result = append(result, types.String{Value: value})
+	}
+	return result
+}
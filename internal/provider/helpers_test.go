@@ -1,8 +1,10 @@
 package provider
 
 import (
+	"context"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"testing"
+	"time"
 
 	"github.com/labd/contentstack-go-sdk/management"
 	"github.com/stretchr/testify/assert"
@@ -60,3 +62,22 @@ func TestCopyHttpBasicPasswordsNotFound(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, result)
 }
+
+func TestWaitFor(t *testing.T) {
+	calls := 0
+	err := waitFor(context.Background(), time.Millisecond, time.Second, func() (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWaitForTimeout(t *testing.T) {
+	err := waitFor(context.Background(), time.Millisecond, 10*time.Millisecond, func() (bool, error) {
+		return false, nil
+	})
+
+	assert.Error(t, err)
+}
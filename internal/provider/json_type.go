@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.TypeWithValidate = jsonType{}
+	_ attr.Value            = JSONValue{}
+)
+
+// jsonDefaults maps the keys of a JSON object to the value Contentstack adds
+// them with. A nil value means the key is managed by Contentstack and its
+// value is ignored, a nested jsonDefaults holds the defaults of an object.
+type jsonDefaults map[string]interface{}
+
+// contentstackDefaultJSONKeys are the keys which Contentstack adds to the
+// fields of a schema. They are ignored when they are only present on one side
+// of the comparison and have their default value.
+var contentstackDefaultJSONKeys = jsonDefaults{
+	"indexed":         nil,
+	"inbuilt_model":   nil,
+	"mandatory":       false,
+	"unique":          false,
+	"multiple":        false,
+	"non_localizable": false,
+	"field_metadata": jsonDefaults{
+		"_default":      nil,
+		"version":       nil,
+		"description":   "",
+		"default_value": "",
+		"instruction":   "",
+	},
+}
+
+// jsonType is a string attribute type holding a JSON document. Values are
+// validated to be valid JSON.
+type jsonType struct{}
+
+func (t jsonType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+func (t jsonType) ValueFromTerraform(_ context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return JSONValue{Unknown: true}, nil
+	}
+	if in.IsNull() {
+		return JSONValue{Null: true}, nil
+	}
+	var s string
+	if err := in.As(&s); err != nil {
+		return nil, err
+	}
+	return JSONValue{Value: s}, nil
+}
+
+func (t jsonType) Equal(o attr.Type) bool {
+	_, ok := o.(jsonType)
+	return ok
+}
+
+func (t jsonType) String() string {
+	return "jsonType"
+}
+
+func (t jsonType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+func (t jsonType) Validate(_ context.Context, in tftypes.Value, path *tftypes.AttributePath) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var s string
+	if err := in.As(&s); err != nil {
+		diags.AddAttributeError(path, "Invalid value", err.Error())
+		return diags
+	}
+
+	if s != "" && !json.Valid([]byte(s)) {
+		diags.AddAttributeError(path, "Invalid JSON", "The value is not a valid JSON document.")
+	}
+	return diags
+}
+
+// JSONValue is the value of a jsonType attribute.
+type JSONValue struct {
+	Unknown bool
+	Null    bool
+	Value   string
+}
+
+func (v JSONValue) Type(_ context.Context) attr.Type {
+	return jsonType{}
+}
+
+func (v JSONValue) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	if v.Null {
+		return tftypes.NewValue(tftypes.String, nil), nil
+	}
+	if v.Unknown {
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+	return tftypes.NewValue(tftypes.String, v.Value), nil
+}
+
+func (v JSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(JSONValue)
+	if !ok {
+		return false
+	}
+	return v == other
+}
+
+func (v JSONValue) IsNull() bool {
+	return v.Null
+}
+
+func (v JSONValue) IsUnknown() bool {
+	return v.Unknown
+}
+
+// SemanticallyEqual reports whether both values hold the same JSON document,
+// see jsonEqual.
+func (v JSONValue) SemanticallyEqual(o JSONValue) bool {
+	if v.Null || v.Unknown || o.Null || o.Unknown {
+		return v == o
+	}
+	return jsonEqual(v.Value, o.Value)
+}
+
+// semanticJSONValue returns the prior value when it is semantically equal to
+// the current value. This keeps the value as written by the user in the state
+// so only actual changes show up in the plan.
+func semanticJSONValue(prior, current JSONValue) JSONValue {
+	if prior.SemanticallyEqual(current) {
+		return prior
+	}
+	return current
+}
+
+// plainJSONValue returns the prior value when it holds the same JSON document
+// as the current value. Unlike semanticJSONValue no keys are ignored, it is
+// used for documents which are not schemas of Contentstack.
+func plainJSONValue(prior, current JSONValue) JSONValue {
+	if prior.Null || prior.Unknown || current.Null || current.Unknown {
+		return current
+	}
+	if jsonPlainEqual(prior.Value, current.Value) {
+		return prior
+	}
+	return current
+}
+
+// jsonPlainEqual reports whether two JSON documents are equal, ignoring only
+// key order and whitespace.
+func jsonPlainEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var av, bv interface{}
+	if err := json.Unmarshal([]byte(a), &av); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bv); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+// jsonEqual reports whether two JSON documents are semantically equal. Key
+// order and whitespace are ignored, as are the keys Contentstack adds with
+// their default value, see contentstackDefaultJSONKeys.
+func jsonEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var av, bv interface{}
+	if err := json.Unmarshal([]byte(a), &av); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bv); err != nil {
+		return false
+	}
+	return jsonValuesEqual(av, bv, contentstackDefaultJSONKeys)
+}
+
+func jsonValuesEqual(a, b interface{}, defaults jsonDefaults) bool {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range av {
+			other, ok := bv[key]
+			if !ok {
+				if !isDefaultJSONKey(defaults, key, value) {
+					return false
+				}
+				continue
+			}
+
+			// Objects without defaults of their own, such as the fields of
+			// a group, have the defaults of a field.
+			nested, ok := defaults[key].(jsonDefaults)
+			if !ok {
+				nested = contentstackDefaultJSONKeys
+			}
+			if !jsonValuesEqual(value, other, nested) {
+				return false
+			}
+		}
+		for key, value := range bv {
+			if _, ok := av[key]; !ok && !isDefaultJSONKey(defaults, key, value) {
+				return false
+			}
+		}
+		return true
+
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonValuesEqual(av[i], bv[i], defaults) {
+				return false
+			}
+		}
+		return true
+
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// isDefaultJSONKey reports whether the key has the value Contentstack adds it
+// with, so it can be omitted without changing the meaning of the object.
+func isDefaultJSONKey(defaults jsonDefaults, key string, value interface{}) bool {
+	expected, ok := defaults[key]
+	if !ok {
+		return false
+	}
+
+	switch expected := expected.(type) {
+	case nil:
+		return true
+	case jsonDefaults:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range object {
+			if !isDefaultJSONKey(expected, key, value) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(expected, value)
+}
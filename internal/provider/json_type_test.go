@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONEqual(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{
+			name:     "whitespace and key order",
+			a:        `[{"uid": "name", "data_type": "text"}]`,
+			b:        `[{"data_type":"text","uid":"name"}]`,
+			expected: true,
+		},
+		{
+			name:     "server added keys",
+			a:        `[{"uid": "name", "data_type": "text"}]`,
+			b:        `[{"uid": "name", "data_type": "text", "mandatory": false, "indexed": false, "field_metadata": {"_default": true, "description": ""}}]`,
+			expected: true,
+		},
+		{
+			name:     "server added keys in nested fields",
+			a:        `[{"uid": "seo", "data_type": "group", "schema": [{"uid": "title", "data_type": "text"}]}]`,
+			b:        `[{"uid": "seo", "data_type": "group", "schema": [{"uid": "title", "data_type": "text", "unique": false, "non_localizable": false}]}]`,
+			expected: true,
+		},
+		{
+			name:     "empty value of other keys",
+			a:        `[{"uid": "name", "data_type": "text"}]`,
+			b:        `[{"uid": "name", "data_type": "text", "display_name": ""}]`,
+			expected: false,
+		},
+		{
+			name:     "metadata set in the UI",
+			a:        `[{"uid": "name", "data_type": "text"}]`,
+			b:        `[{"uid": "name", "data_type": "text", "field_metadata": {"_default": true, "description": "Shown to editors"}}]`,
+			expected: false,
+		},
+		{
+			name:     "changed value",
+			a:        `[{"uid": "name", "data_type": "text"}]`,
+			b:        `[{"uid": "name", "data_type": "number"}]`,
+			expected: false,
+		},
+		{
+			name:     "field added in the UI",
+			a:        `[{"uid": "name", "data_type": "text"}]`,
+			b:        `[{"uid": "name", "data_type": "text"}, {"uid": "title", "data_type": "text"}]`,
+			expected: false,
+		},
+		{
+			name:     "property enabled in the UI",
+			a:        `[{"uid": "name", "data_type": "text"}]`,
+			b:        `[{"uid": "name", "data_type": "text", "mandatory": true}]`,
+			expected: false,
+		},
+		{
+			name:     "field order",
+			a:        `[{"uid": "name"}, {"uid": "title"}]`,
+			b:        `[{"uid": "title"}, {"uid": "name"}]`,
+			expected: false,
+		},
+		{
+			name:     "invalid json",
+			a:        `[{"uid": "name"}]`,
+			b:        `[{"uid": "name"`,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, jsonEqual(tc.a, tc.b))
+			assert.Equal(t, tc.expected, jsonEqual(tc.b, tc.a))
+		})
+	}
+}
+
+func TestSemanticJSONValue(t *testing.T) {
+	prior := JSONValue{Value: `{"uid": "name"}`}
+
+	result := semanticJSONValue(prior, JSONValue{Value: `{"uid":"name","unique":false}`})
+	assert.Equal(t, prior, result)
+
+	current := JSONValue{Value: `{"uid":"title"}`}
+	result = semanticJSONValue(prior, current)
+	assert.Equal(t, current, result)
+
+	result = semanticJSONValue(JSONValue{Null: true}, current)
+	assert.Equal(t, current, result)
+}
+
+func TestPlainJSONValue(t *testing.T) {
+	prior := JSONValue{Value: `{"uid": "name", "multiple": true}`}
+
+	result := plainJSONValue(prior, JSONValue{Value: `{"multiple":true,"uid":"name"}`})
+	assert.Equal(t, prior, result)
+
+	current := JSONValue{Value: `{"uid":"name","multiple":true,"unique":false}`}
+	result = plainJSONValue(prior, current)
+	assert.Equal(t, current, result)
+
+	result = plainJSONValue(JSONValue{Null: true}, current)
+	assert.Equal(t, current, result)
+}
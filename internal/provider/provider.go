@@ -2,14 +2,34 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/labd/contentstack-go-sdk/management"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
 )
 
+// regionBaseURLs maps the Contentstack regions to the base url of the
+// Content Management API in that region.
+var regionBaseURLs = map[string]string{
+	"na":       "https://api.contentstack.io/",
+	"eu":       "https://eu-api.contentstack.com/",
+	"au":       "https://au-api.contentstack.com/",
+	"azure-na": "https://azure-na-api.contentstack.com/",
+	"azure-eu": "https://azure-eu-api.contentstack.com/",
+	"gcp-na":   "https://gcp-na-api.contentstack.com/",
+	"gcp-eu":   "https://gcp-eu-api.contentstack.com/",
+}
+
 func New(version string) func() tfsdk.Provider {
 	return func() tfsdk.Provider {
 		return &provider{version: version}
@@ -17,7 +37,7 @@ func New(version string) func() tfsdk.Provider {
 }
 
 type provider struct {
-	stack   *management.StackInstance
+	stack   *contentstack.Stack
 	client  *management.Client
 	version string
 }
@@ -29,29 +49,49 @@ func (p *provider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics)
 			"base_url": {
 				Type:        types.StringType,
 				Optional:    true,
-				Description: "The BaseURL, e.g. https://eu-api.contentstack.com/. See https://www.contentstack.com/docs/developers/apis/content-management-api/#base-url",
+				Description: "The BaseURL, e.g. https://eu-api.contentstack.com/. See https://www.contentstack.com/docs/developers/apis/content-management-api/#base-url. Overrides the url derived from the region. Can also be set via the CONTENTSTACK_BASE_URL environment variable.",
+			},
+			"region": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The region of the stack, one of " + strings.Join(regionNames(), ", ") + ". Used to determine the base_url when that is not set. Can also be set via the CONTENTSTACK_REGION environment variable.",
 			},
 			"api_key": {
 				Type:        types.StringType,
 				Optional:    true,
-				Description: "The API key is a unique key assigned to each stack.",
+				Description: "The API key is a unique key assigned to each stack. Can also be set via the CONTENTSTACK_API_KEY environment variable.",
 			},
 			"management_token": {
 				Type:        types.StringType,
 				Optional:    true,
 				Sensitive:   true,
-				Description: "Management Tokens are stack-level tokens, with no users attached to them.",
+				Description: "Management Tokens are stack-level tokens, with no users attached to them. Can also be set via the CONTENTSTACK_MANAGEMENT_TOKEN environment variable.",
 			},
 			"auth_token": {
 				Type:        types.StringType,
 				Optional:    true,
 				Sensitive:   true,
-				Description: "The Authtoken is a read-write token used to make authorized CMA requests, and it is a user-specific token.",
+				Description: "The Authtoken is a read-write token used to make authorized CMA requests, and it is a user-specific token. Can also be set via the CONTENTSTACK_AUTH_TOKEN environment variable.",
 			},
 			"branch": {
 				Type:        types.StringType,
 				Optional:    true,
-				Description: "The branch to manage resources in. If not specified, the main branch will be used.",
+				Description: "The branch to manage resources in. If not specified, the main branch will be used. Can also be set via the CONTENTSTACK_BRANCH environment variable.",
+			},
+			"max_retries": {
+				Type:        types.Int64Type,
+				Optional:    true,
+				Description: "The maximum number of times a request is retried when it is rate limited (HTTP 429) or fails with a server error. Defaults to 5.",
+			},
+			"max_backoff": {
+				Type:        types.Int64Type,
+				Optional:    true,
+				Description: "The maximum number of seconds to wait between two retries of a request. Defaults to 30.",
+			},
+			"requests_per_second": {
+				Type:        types.Float64Type,
+				Optional:    true,
+				Description: "The maximum number of requests per second sent to Contentstack, shared by all resources. Use this to stay within the rate limit of your plan. Requests are not limited when not set.",
 			},
 		},
 	}, nil
@@ -59,11 +99,162 @@ func (p *provider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics)
 
 // Provider schema struct
 type providerData struct {
-	BaseURL         types.String `tfsdk:"base_url"`
-	AuthToken       types.String `tfsdk:"auth_token"`
-	ApiKey          types.String `tfsdk:"api_key"`
-	ManagementToken types.String `tfsdk:"management_token"`
-	Branch          types.String `tfsdk:"branch"`
+	BaseURL           types.String  `tfsdk:"base_url"`
+	Region            types.String  `tfsdk:"region"`
+	AuthToken         types.String  `tfsdk:"auth_token"`
+	ApiKey            types.String  `tfsdk:"api_key"`
+	ManagementToken   types.String  `tfsdk:"management_token"`
+	Branch            types.String  `tfsdk:"branch"`
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	MaxBackoff        types.Int64   `tfsdk:"max_backoff"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+}
+
+// applyEnvDefaults sets the values which are not defined in the provider
+// configuration from their corresponding environment variable.
+func (d *providerData) applyEnvDefaults() {
+	d.BaseURL = stringWithEnvDefault(d.BaseURL, "CONTENTSTACK_BASE_URL")
+	d.Region = stringWithEnvDefault(d.Region, "CONTENTSTACK_REGION")
+	d.ApiKey = stringWithEnvDefault(d.ApiKey, "CONTENTSTACK_API_KEY")
+	d.ManagementToken = stringWithEnvDefault(d.ManagementToken, "CONTENTSTACK_MANAGEMENT_TOKEN")
+	d.AuthToken = stringWithEnvDefault(d.AuthToken, "CONTENTSTACK_AUTH_TOKEN")
+	d.Branch = stringWithEnvDefault(d.Branch, "CONTENTSTACK_BRANCH")
+}
+
+// validateAuth checks that a management token or an auth token is set. The
+// check is skipped while a token is unknown, since it may be set once known.
+func (d *providerData) validateAuth() diag.Diagnostics {
+	var diags diag.Diagnostics
+	if d.ManagementToken.Unknown || d.AuthToken.Unknown {
+		return diags
+	}
+
+	if d.ManagementToken.Value == "" && d.AuthToken.Value == "" {
+		diags.AddError(
+			"Missing authentication token",
+			"Either a management token or an auth token is required to manage "+
+				"the stack. Set the management_token or auth_token attribute in "+
+				"the provider configuration, or use the CONTENTSTACK_MANAGEMENT_TOKEN "+
+				"or CONTENTSTACK_AUTH_TOKEN environment variable.",
+		)
+	}
+	return diags
+}
+
+// resolveBaseURL returns the base url of the Content Management API. The
+// base_url takes precedence over the region, but when both are set they need
+// to point to the same endpoint.
+func (d *providerData) resolveBaseURL() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if d.Region.Value == "" {
+		return d.BaseURL.Value, diags
+	}
+
+	regionURL, ok := regionBaseURLs[d.Region.Value]
+	if !ok {
+		diags.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("region"),
+			"Invalid region",
+			fmt.Sprintf(
+				"The region %s is not supported. Valid regions are: %s",
+				d.Region.Value, strings.Join(regionNames(), ", ")),
+		)
+		return "", diags
+	}
+
+	if d.BaseURL.Value == "" {
+		return regionURL, diags
+	}
+
+	if strings.TrimSuffix(d.BaseURL.Value, "/") != strings.TrimSuffix(regionURL, "/") {
+		diags.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("base_url"),
+			"Conflicting base_url and region",
+			fmt.Sprintf(
+				"The base_url %s does not match the base url %s of region %s. "+
+					"Either remove one of the two settings or make them consistent.",
+				d.BaseURL.Value, regionURL, d.Region.Value),
+		)
+		return "", diags
+	}
+	return d.BaseURL.Value, diags
+}
+
+// newTransport creates the http transport used for all requests to
+// Contentstack.
+func (d *providerData) newTransport() (http.RoundTripper, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var next http.RoundTripper = management.DebugTransport
+	if !d.RequestsPerSecond.Null && !d.RequestsPerSecond.Unknown {
+		if d.RequestsPerSecond.Value <= 0 {
+			diags.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("requests_per_second"),
+				"Invalid requests_per_second",
+				"The requests_per_second setting should be greater than 0.",
+			)
+			return nil, diags
+		}
+
+		// The rate limiter is placed below the retry transport so that
+		// retries are throttled as well.
+		next = &rateLimitTransport{
+			transport: next,
+			limiter:   newTokenBucket(d.RequestsPerSecond.Value),
+		}
+	}
+
+	transport := &retryTransport{
+		transport:  next,
+		maxRetries: defaultMaxRetries,
+		minBackoff: defaultMinBackoff,
+		maxBackoff: defaultMaxBackoff,
+	}
+
+	if !d.MaxRetries.Null && !d.MaxRetries.Unknown {
+		if d.MaxRetries.Value < 0 {
+			diags.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("max_retries"),
+				"Invalid max_retries",
+				"The max_retries setting cannot be negative.",
+			)
+		}
+		transport.maxRetries = int(d.MaxRetries.Value)
+	}
+
+	if !d.MaxBackoff.Null && !d.MaxBackoff.Unknown {
+		if d.MaxBackoff.Value < 1 {
+			diags.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("max_backoff"),
+				"Invalid max_backoff",
+				"The max_backoff setting should be at least 1 second.",
+			)
+		}
+		transport.maxBackoff = time.Duration(d.MaxBackoff.Value) * time.Second
+	}
+
+	return transport, diags
+}
+
+func regionNames() []string {
+	names := make([]string, 0, len(regionBaseURLs))
+	for name := range regionBaseURLs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func stringWithEnvDefault(v types.String, key string) types.String {
+	if v.Unknown || (!v.Null && v.Value != "") {
+		return v
+	}
+
+	if value, ok := os.LookupEnv(key); ok {
+		return types.String{Value: value}
+	}
+	return v
 }
 
 func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
@@ -76,11 +267,35 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 		return
 	}
 
+	config.applyEnvDefaults()
+
+	diags = config.validateAuth()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The tokens can refer to resources which are not created yet. The
+	// client is created once Terraform configures the provider with the
+	// known values.
+	if config.ManagementToken.Unknown || config.AuthToken.Unknown {
+		return
+	}
+
+	baseURL, diags := config.resolveBaseURL()
+	resp.Diagnostics.Append(diags...)
+
+	transport, diags := config.newTransport()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cfg := management.ClientConfig{
-		BaseURL:   config.BaseURL.Value,
+		BaseURL:   baseURL,
 		AuthToken: config.AuthToken.Value,
 		HTTPClient: &http.Client{
-			Transport: management.DebugTransport,
+			Transport: transport,
 		},
 	}
 
@@ -108,18 +323,46 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 		return
 	}
 
+	// The stack adds the endpoints which are not supported by the SDK.
+	stack, err := contentstack.NewStack(instance, cfg, stackAuth)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create stack client",
+			"Unable to create contentstack stack client:\n\n"+err.Error(),
+		)
+		return
+	}
+
 	p.client = c
-	p.stack = instance
+	p.stack = stack
 }
 
 // GetResources - Defines provider resources
 func (p *provider) GetResources(_ context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	return map[string]tfsdk.ResourceType{
-		"contentstack_content_type": resourceContentTypeType{},
-		"contentstack_environment":  resourceEnvironmentType{},
-		"contentstack_global_field": resourceGlobalFieldType{},
-		"contentstack_locale":       resourceLocaleType{},
-		"contentstack_webhook":      resourceWebhookType{},
+		"contentstack_asset":                 resourceAssetType{},
+		"contentstack_asset_folder":          resourceAssetFolderType{},
+		"contentstack_branch":                resourceBranchType{},
+		"contentstack_branch_alias":          resourceBranchAliasType{},
+		"contentstack_content_type":          resourceContentTypeType{},
+		"contentstack_delivery_token":        resourceDeliveryTokenType{},
+		"contentstack_entry":                 resourceEntryType{},
+		"contentstack_entry_publish":         resourceEntryPublishType{},
+		"contentstack_environment":           resourceEnvironmentType{},
+		"contentstack_extension":             resourceExtensionType{},
+		"contentstack_global_field":          resourceGlobalFieldType{},
+		"contentstack_label":                 resourceLabelType{},
+		"contentstack_locale":                resourceLocaleType{},
+		"contentstack_live_preview_settings": resourceLivePreviewSettingsType{},
+		"contentstack_management_token":      resourceManagementTokenType{},
+		"contentstack_preview_token":         resourcePreviewTokenType{},
+		"contentstack_publish_rule":          resourcePublishRuleType{},
+		"contentstack_release":               resourceReleaseType{},
+		"contentstack_role":                  resourceRoleType{},
+		"contentstack_taxonomy":              resourceTaxonomyType{},
+		"contentstack_term":                  resourceTermType{},
+		"contentstack_webhook":               resourceWebhookType{},
+		"contentstack_workflow":              resourceWorkflowType{},
 	}, nil
 }
 
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderDataApplyEnvDefaults(t *testing.T) {
+	t.Setenv("CONTENTSTACK_BASE_URL", "https://eu-api.contentstack.com/")
+	t.Setenv("CONTENTSTACK_API_KEY", "env-api-key")
+	t.Setenv("CONTENTSTACK_MANAGEMENT_TOKEN", "env-token")
+	t.Setenv("CONTENTSTACK_BRANCH", "development")
+
+	data := providerData{
+		BaseURL:         types.String{Null: true},
+		ApiKey:          types.String{Value: "config-api-key"},
+		ManagementToken: types.String{Null: true},
+		AuthToken:       types.String{Null: true},
+		Branch:          types.String{Value: ""},
+	}
+	data.applyEnvDefaults()
+
+	assert.Equal(t, "https://eu-api.contentstack.com/", data.BaseURL.Value)
+	assert.Equal(t, "config-api-key", data.ApiKey.Value)
+	assert.Equal(t, "env-token", data.ManagementToken.Value)
+	assert.True(t, data.AuthToken.Null)
+	assert.Equal(t, "development", data.Branch.Value)
+}
+
+func TestProviderDataValidateAuth(t *testing.T) {
+	t.Setenv("CONTENTSTACK_MANAGEMENT_TOKEN", "")
+	t.Setenv("CONTENTSTACK_AUTH_TOKEN", "")
+
+	data := providerData{
+		ManagementToken: types.String{Null: true},
+		AuthToken:       types.String{Null: true},
+	}
+	data.applyEnvDefaults()
+	assert.True(t, data.validateAuth().HasError())
+
+	// A token which depends on another resource is validated once known.
+	data.ManagementToken = types.String{Unknown: true}
+	data.applyEnvDefaults()
+	assert.True(t, data.ManagementToken.Unknown)
+	assert.False(t, data.validateAuth().HasError())
+
+	data.ManagementToken = types.String{Value: "token"}
+	assert.False(t, data.validateAuth().HasError())
+}
+
+func TestProviderDataResolveBaseURL(t *testing.T) {
+	testCases := []struct {
+		name     string
+		baseURL  string
+		region   string
+		expected string
+		hasError bool
+	}{
+		{name: "base url only", baseURL: "https://example.com/", expected: "https://example.com/"},
+		{name: "region only", region: "azure-eu", expected: "https://azure-eu-api.contentstack.com/"},
+		{name: "matching base url and region", baseURL: "https://eu-api.contentstack.com", region: "eu", expected: "https://eu-api.contentstack.com"},
+		{name: "conflicting base url and region", baseURL: "https://eu-api.contentstack.com/", region: "na", hasError: true},
+		{name: "unknown region", region: "mars", hasError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := providerData{
+				BaseURL: types.String{Value: tc.baseURL},
+				Region:  types.String{Value: tc.region},
+			}
+
+			result, diags := data.resolveBaseURL()
+			assert.Equal(t, tc.hasError, diags.HasError())
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
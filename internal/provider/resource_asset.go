@@ -0,0 +1,354 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+type resourceAssetType struct{}
+
+type AssetData struct {
+	UID         types.String   `tfsdk:"uid"`
+	File        types.String   `tfsdk:"file"`
+	SourceHash  types.String   `tfsdk:"source_hash"`
+	Title       types.String   `tfsdk:"title"`
+	Description types.String   `tfsdk:"description"`
+	Folder      types.String   `tfsdk:"folder"`
+	Tags        []types.String `tfsdk:"tags"`
+	Filename    types.String   `tfsdk:"filename"`
+	URL         types.String   `tfsdk:"url"`
+	FileSize    types.Int64    `tfsdk:"file_size"`
+}
+
+// Asset Resource schema
+func (r resourceAssetType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		An asset is a file uploaded to the stack, such as an image or a
+		document. The file is uploaded again when its content changes, the UID
+		of the asset stays the same.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"file": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The path of the local file to upload.",
+			},
+			"source_hash": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The SHA-256 hash of the content of the uploaded file.",
+			},
+			"title": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The title of the asset. Defaults to the name of the file.",
+			},
+			"description": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"folder": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The UID of the folder of the asset. The asset is stored in the root folder when not set.",
+			},
+			"tags": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"filename": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The name of the uploaded file.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"url": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The URL of the uploaded file.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"file_size": {
+				Type:        types.Int64Type,
+				Computed:    true,
+				Description: "The size of the uploaded file in bytes.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceAssetType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceAsset{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceAsset struct {
+	p provider
+}
+
+// ModifyPlan sets the hash of the local file, so a change of the content
+// results in a new upload.
+func (r resourceAsset) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	// Nothing to upload when the asset is deleted.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AssetData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The file may be created by another resource during the apply.
+	if plan.File.Unknown {
+		plan.SourceHash = types.String{Unknown: true}
+	} else {
+		hash, err := fileHash(plan.File.Value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("file"),
+				"Unable to read file",
+				err.Error(),
+			)
+			return
+		}
+		plan.SourceHash = types.String{Value: hash}
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state AssetData
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if assetNeedsUpload(&state, &plan) {
+			plan.Filename = types.String{Unknown: true}
+			plan.URL = types.String{Unknown: true}
+			plan.FileSize = types.Int64{Unknown: true}
+		}
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceAsset) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan AssetData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, err := os.Open(plan.File.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read file", err.Error())
+		return
+	}
+	defer file.Close()
+
+	input := NewAssetInput(&plan)
+	input.File = file
+	asset, err := r.p.stack.AssetCreate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state := NewAssetData(asset, &plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceAsset) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state AssetData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asset, err := r.p.stack.AssetFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Asset not found",
+				fmt.Sprintf("The asset with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState := NewAssetData(asset, &state)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceAsset) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state AssetData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete asset by calling API
+	err := r.p.stack.AssetDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceAsset) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan AssetData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state AssetData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the details are updated when the file is unchanged.
+	input := NewAssetInput(&plan)
+	if assetNeedsUpload(&state, &plan) {
+		file, err := os.Open(plan.File.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read file", err.Error())
+			return
+		}
+		defer file.Close()
+		input.File = file
+	}
+
+	asset, err := r.p.stack.AssetUpdate(ctx, state.UID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	result := NewAssetData(asset, &plan)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceAsset) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+// assetNeedsUpload reports whether the file needs to be uploaded again,
+// because either the file or its content changed.
+func assetNeedsUpload(state, plan *AssetData) bool {
+	return !plan.File.Equal(state.File) || !plan.SourceHash.Equal(state.SourceHash)
+}
+
+// fileHash returns the hex encoded SHA-256 hash of the content of the file.
+func fileHash(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func NewAssetData(asset *contentstack.Asset, prior *AssetData) *AssetData {
+	state := &AssetData{
+		UID:         types.String{Value: asset.UID},
+		File:        prior.File,
+		SourceHash:  prior.SourceHash,
+		Title:       types.String{Value: asset.Title},
+		Description: types.String{Value: asset.Description},
+		Folder:      types.String{Null: true},
+		Filename:    types.String{Value: asset.Filename},
+		URL:         types.String{Value: asset.URL},
+		FileSize:    types.Int64{Value: asset.FileSize},
+	}
+	if asset.Title == asset.Filename && prior.Title.Null {
+		state.Title = prior.Title
+	}
+	if asset.Description == "" && prior.Description.Null {
+		state.Description = prior.Description
+	}
+	if asset.ParentUID != "" {
+		state.Folder = types.String{Value: asset.ParentUID}
+	}
+	if len(asset.Tags) > 0 || prior.Tags != nil {
+		state.Tags = newStringList(asset.Tags)
+	}
+	return state
+}
+
+// NewAssetInput converts the asset to the input for Contentstack. The file to
+// upload is not set.
+func NewAssetInput(asset *AssetData) *contentstack.AssetInput {
+	input := &contentstack.AssetInput{
+		Filename:    filepath.Base(asset.File.Value),
+		Title:       stringWithDefault(asset.Title.Value, filepath.Base(asset.File.Value)),
+		Description: asset.Description.Value,
+		ParentUID:   asset.Folder.Value,
+		Tags:        []string{},
+	}
+	for _, tag := range asset.Tags {
+		input.Tags = append(input.Tags, tag.Value)
+	}
+	return input
+}
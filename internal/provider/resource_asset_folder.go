@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+type resourceAssetFolderType struct{}
+
+type AssetFolderData struct {
+	UID    types.String `tfsdk:"uid"`
+	Name   types.String `tfsdk:"name"`
+	Parent types.String `tfsdk:"parent"`
+}
+
+// Asset Folder Resource schema
+func (r resourceAssetFolderType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		Asset folders are used to organise the assets of the stack. Folders
+		can be nested by setting a parent folder.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"parent": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The UID of the parent folder. The folder is created in the root folder when not set.",
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceAssetFolderType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceAssetFolder{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceAssetFolder struct {
+	p provider
+}
+
+func (r resourceAssetFolder) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan AssetFolderData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := NewAssetFolderInput(&plan)
+	folder, err := r.p.stack.AssetFolderCreate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state := NewAssetFolderData(folder)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceAssetFolder) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state AssetFolderData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.p.stack.AssetFolderFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Asset folder not found",
+				fmt.Sprintf("The asset folder with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState := NewAssetFolderData(folder)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceAssetFolder) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state AssetFolderData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete folder by calling API
+	err := r.p.stack.AssetFolderDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceAssetFolder) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan AssetFolderData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state AssetFolderData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing the parent moves the folder.
+	input := NewAssetFolderInput(&plan)
+	folder, err := r.p.stack.AssetFolderUpdate(ctx, state.UID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	result := NewAssetFolderData(folder)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceAssetFolder) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+func NewAssetFolderData(folder *contentstack.AssetFolder) *AssetFolderData {
+	state := &AssetFolderData{
+		UID:    types.String{Value: folder.UID},
+		Name:   types.String{Value: folder.Name},
+		Parent: types.String{Null: true},
+	}
+	if folder.ParentUID != "" {
+		state.Parent = types.String{Value: folder.ParentUID}
+	}
+	return state
+}
+
+func NewAssetFolderInput(folder *AssetFolderData) *contentstack.AssetFolderInput {
+	input := &contentstack.AssetFolderInput{
+		Name:      folder.Name.Value,
+		ParentUID: folder.Parent.Value,
+	}
+	return input
+}
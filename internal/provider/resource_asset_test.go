@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestFileHash(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logo.svg")
+	assert.NoError(t, os.WriteFile(path, []byte("<svg></svg>"), 0o600))
+
+	hash, err := fileHash(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "b12e0d83ce2357d80b89c57694814d0a3abdaf8c40724f2049af8b7f01b7812b", hash)
+
+	_, err = fileHash(filepath.Join(t.TempDir(), "missing.svg"))
+	assert.Error(t, err)
+}
+
+func TestAssetRoundTrip(t *testing.T) {
+	plan := &AssetData{
+		File:        types.String{Value: "assets/logo.svg"},
+		SourceHash:  types.String{Value: "b12e0d83"},
+		Title:       types.String{Null: true},
+		Description: types.String{Null: true},
+		Folder:      types.String{Value: "blt2"},
+		Filename:    types.String{Unknown: true},
+		URL:         types.String{Unknown: true},
+		FileSize:    types.Int64{Unknown: true},
+	}
+
+	input := NewAssetInput(plan)
+	assert.Equal(t, "logo.svg", input.Filename)
+	assert.Equal(t, "logo.svg", input.Title)
+	assert.Nil(t, input.File)
+
+	asset := &contentstack.Asset{
+		UID:       "blt1",
+		Filename:  input.Filename,
+		Title:     input.Title,
+		ParentUID: input.ParentUID,
+		URL:       "https://images.contentstack.io/v3/assets/blt0/blt1/logo.svg",
+		FileSize:  11,
+	}
+	state := NewAssetData(asset, plan)
+	assert.Equal(t, plan.File, state.File)
+	assert.Equal(t, plan.SourceHash, state.SourceHash)
+	assert.True(t, state.Title.Null)
+	assert.True(t, state.Description.Null)
+	assert.Equal(t, "blt2", state.Folder.Value)
+	assert.Equal(t, int64(11), state.FileSize.Value)
+	assert.Nil(t, state.Tags)
+
+	// Only a change of the file or its content requires a new upload.
+	planned := *state
+	planned.Title = types.String{Value: "Logo"}
+	assert.False(t, assetNeedsUpload(state, &planned))
+	planned.SourceHash = types.String{Value: "0f3a9c71"}
+	assert.True(t, assetNeedsUpload(state, &planned))
+}
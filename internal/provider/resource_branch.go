@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+// Branches are created and deleted asynchronously by Contentstack. The state
+// of the branch is polled every branchPollInterval until the operation is
+// finished.
+const (
+	branchPollInterval = 5 * time.Second
+	branchTimeout      = 15 * time.Minute
+)
+
+type resourceBranchType struct{}
+
+type BranchData struct {
+	UID    types.String `tfsdk:"uid"`
+	Source types.String `tfsdk:"source"`
+}
+
+// Branch Resource schema
+func (r resourceBranchType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		Branches allow you to work on independent copies of the content types,
+		global fields and entries of a stack. A branch is created from a source
+		branch and contains a copy of all its content.
+
+		Note: Branches are immutable, so changing any of the attributes deletes
+		the branch, including all its content, and creates a new branch.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The unique ID of the branch.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"source": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The UID of the branch or alias to copy the content from.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceBranchType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceBranch{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceBranch struct {
+	p provider
+}
+
+func (r resourceBranch) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan BranchData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := NewBranchInput(&plan)
+	_, err := r.p.stack.BranchCreate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// The branch is only available once all content is copied from the
+	// source branch.
+	var branch *contentstack.Branch
+	err = waitFor(ctx, branchPollInterval, branchTimeout, func() (bool, error) {
+		branch, err = r.p.stack.BranchFetch(ctx, plan.UID.Value)
+		if IsNotFoundError(err) {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Branch not created",
+			fmt.Sprintf("The branch with UID %s could not be created: %s", plan.UID.Value, err.Error()),
+		)
+		return
+	}
+
+	// Write to state.
+	state := NewBranchData(branch, &plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceBranch) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state BranchData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branch, err := r.p.stack.BranchFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Branch not found",
+				fmt.Sprintf("The branch with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState := NewBranchData(branch, &state)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceBranch) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state BranchData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete branch by calling API
+	err := r.p.stack.BranchDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Wait until the branch is gone, so a replacement with the same UID can
+	// be created directly afterwards.
+	err = waitFor(ctx, branchPollInterval, branchTimeout, func() (bool, error) {
+		_, err := r.p.stack.BranchFetch(ctx, state.UID.Value)
+		if IsNotFoundError(err) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Branch not deleted",
+			fmt.Sprintf("The branch with UID %s could not be deleted: %s", state.UID.Value, err.Error()),
+		)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+// Update is never called since all attributes require the branch to be
+// replaced.
+func (r resourceBranch) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError(
+		"Branch cannot be updated",
+		"Branches are immutable, any change requires the branch to be replaced.",
+	)
+}
+
+func (r resourceBranch) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+// NewBranchData converts the branch to its state. Contentstack returns the
+// branch an alias pointed to as the source, so the prior source is kept. The
+// source of a branch never changes, it is only read from the branch when it
+// is imported.
+func NewBranchData(branch *contentstack.Branch, prior *BranchData) *BranchData {
+	state := &BranchData{
+		UID:    types.String{Value: branch.UID},
+		Source: types.String{Value: branch.Source},
+	}
+	if prior != nil && !prior.Source.Null && prior.Source.Value != "" {
+		state.Source = prior.Source
+	}
+	return state
+}
+
+func NewBranchInput(branch *BranchData) *contentstack.BranchInput {
+	input := &contentstack.BranchInput{
+		UID:    branch.UID.Value,
+		Source: branch.Source.Value,
+	}
+	return input
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+type resourceBranchAliasType struct{}
+
+type BranchAliasData struct {
+	UID          types.String `tfsdk:"uid"`
+	TargetBranch types.String `tfsdk:"target_branch"`
+}
+
+// Branch Alias Resource schema
+func (r resourceBranchAliasType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		A branch alias is a pointer to a branch. It can be used instead of the
+		branch UID in API requests and delivery tokens, so the branch can be
+		switched without changing the integrations using the alias.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The unique ID of the alias.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"target_branch": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The UID of the branch the alias points to.",
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceBranchAliasType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceBranchAlias{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceBranchAlias struct {
+	p provider
+}
+
+func (r resourceBranchAlias) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan BranchAliasData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := NewBranchAliasInput(&plan)
+	alias, err := r.p.stack.BranchAliasAssign(ctx, plan.UID.Value, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state := NewBranchAliasData(alias)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceBranchAlias) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state BranchAliasData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := r.p.stack.BranchAliasFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Branch alias not found",
+				fmt.Sprintf("The branch alias with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState := NewBranchAliasData(alias)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceBranchAlias) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state BranchAliasData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete alias by calling API
+	err := r.p.stack.BranchAliasDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceBranchAlias) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan BranchAliasData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Assigning the alias to another branch replaces the current target.
+	input := NewBranchAliasInput(&plan)
+	alias, err := r.p.stack.BranchAliasAssign(ctx, plan.UID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	result := NewBranchAliasData(alias)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceBranchAlias) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+func NewBranchAliasData(alias *contentstack.BranchAlias) *BranchAliasData {
+	state := &BranchAliasData{
+		UID:          types.String{Value: alias.UID},
+		TargetBranch: types.String{Value: alias.TargetBranch},
+	}
+	return state
+}
+
+func NewBranchAliasInput(alias *BranchAliasData) *contentstack.BranchAliasInput {
+	input := &contentstack.BranchAliasInput{
+		TargetBranch: alias.TargetBranch.Value,
+	}
+	return input
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestBranchAliasRoundTrip(t *testing.T) {
+	plan := &BranchAliasData{
+		UID:          types.String{Value: "deploy"},
+		TargetBranch: types.String{Value: "release"},
+	}
+
+	input := NewBranchAliasInput(plan)
+	assert.Equal(t, &contentstack.BranchAliasInput{TargetBranch: "release"}, input)
+
+	state := NewBranchAliasData(&contentstack.BranchAlias{UID: "deploy", TargetBranch: input.TargetBranch})
+	assert.Equal(t, plan, state)
+}
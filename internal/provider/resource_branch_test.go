@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestBranchRoundTrip(t *testing.T) {
+	plan := &BranchData{
+		UID:    types.String{Value: "development"},
+		Source: types.String{Value: "main"},
+	}
+
+	input := NewBranchInput(plan)
+	assert.Equal(t, &contentstack.BranchInput{UID: "development", Source: "main"}, input)
+
+	state := NewBranchData(&contentstack.Branch{UID: input.UID, Source: input.Source}, plan)
+	assert.Equal(t, plan, state)
+}
+
+func TestBranchSourceAlias(t *testing.T) {
+	prior := &BranchData{
+		UID:    types.String{Value: "development"},
+		Source: types.String{Value: "production"},
+	}
+
+	// The branch the alias points to is returned as the source.
+	state := NewBranchData(&contentstack.Branch{UID: "development", Source: "main"}, prior)
+	assert.Equal(t, prior, state)
+
+	// On import there is no prior source.
+	state = NewBranchData(&contentstack.Branch{UID: "development", Source: "main"}, &BranchData{
+		UID:    types.String{Value: "development"},
+		Source: types.String{Null: true},
+	})
+	assert.Equal(t, "main", state.Source.Value)
+}
@@ -4,21 +4,38 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/labd/contentstack-go-sdk/management"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
 )
 
 type resourceContentTypeType struct{}
 
 type ContentTypeData struct {
-	UID         types.String `tfsdk:"uid"`
-	Title       types.String `tfsdk:"title"`
-	Description types.String `tfsdk:"description"`
-	Schema      types.String `tfsdk:"schema"`
+	UID                     types.String               `tfsdk:"uid"`
+	Title                   types.String               `tfsdk:"title"`
+	Description             types.String               `tfsdk:"description"`
+	Schema                  JSONValue                  `tfsdk:"schema"`
+	AllowDestructiveChanges types.Bool                 `tfsdk:"allow_destructive_changes"`
+	Fields                  types.List                 `tfsdk:"field"`
+	Options                 []ContentTypeOptionsData   `tfsdk:"options"`
+	FieldRules              []ContentTypeFieldRuleData `tfsdk:"field_rule"`
+}
+
+type ContentTypeOptionsData struct {
+	Singleton  types.Bool     `tfsdk:"singleton"`
+	IsPage     types.Bool     `tfsdk:"is_page"`
+	Title      types.String   `tfsdk:"title"`
+	SubTitle   []types.String `tfsdk:"sub_title"`
+	URLPattern types.String   `tfsdk:"url_pattern"`
+	URLPrefix  types.String   `tfsdk:"url_prefix"`
 }
 
 // Global Field Resource schema
@@ -31,7 +48,9 @@ func (r resourceContentTypeType) GetSchema(_ context.Context) (tfsdk.Schema, dia
 		using the content type.
 
 		Note: Removing a field or modifying its properties may result in data
-		loss or invalidate field visibility rules.
+		loss. Plans which remove fields or change their data type fail unless
+		allow_destructive_changes is set. Field visibility rules referring to
+		fields which are not part of the schema are rejected when planning.
 		`,
 		Attributes: map[string]tfsdk.Attribute{
 			"uid": {
@@ -48,9 +67,59 @@ func (r resourceContentTypeType) GetSchema(_ context.Context) (tfsdk.Schema, dia
 				Optional: true,
 			},
 			"schema": {
-				Type:        types.StringType,
+				Type:        jsonType{},
 				Optional:    true,
-				Description: "The schema as JSON. Use jsonencode(jsonecode(<schema>)) to work around wrong changes.",
+				Description: "The schema as JSON. Differences in formatting, key order and default values added by Contentstack are ignored. Conflicts with `field`.",
+			},
+			"allow_destructive_changes": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Allow changes which remove fields or change their data type. These changes delete the data of the fields in all entries. Defaults to false.",
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"field": {
+				NestingMode: tfsdk.BlockNestingModeList,
+				Description: "The fields of the content type, as an alternative to the JSON schema. Conflicts with `schema`.",
+				Attributes:  contentTypeFieldAttributes(1),
+			},
+			"field_rule": contentTypeFieldRuleBlock(),
+			"options": {
+				NestingMode: tfsdk.BlockNestingModeList,
+				MaxItems:    1,
+				Description: "The options of the content type. The options are left untouched when this block is not set.",
+				Attributes: map[string]tfsdk.Attribute{
+					"singleton": {
+						Type:        types.BoolType,
+						Optional:    true,
+						Description: "Whether the content type allows a single entry only. Defaults to false.",
+					},
+					"is_page": {
+						Type:        types.BoolType,
+						Optional:    true,
+						Description: "Whether the entries of the content type are web pages with an URL. Defaults to false.",
+					},
+					"title": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The UID of the field used as title of the entries. Defaults to `title`.",
+					},
+					"sub_title": {
+						Type:        types.ListType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "The UIDs of the fields shown as sub title of the entries.",
+					},
+					"url_pattern": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The pattern of the URL of the entries, e.g. `/:title`. Only when `is_page` is true.",
+					},
+					"url_prefix": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The prefix of the URL of the entries, e.g. `/blog/`. Only when `is_page` is true.",
+					},
+				},
 			},
 		},
 	}, nil
@@ -67,6 +136,110 @@ type resourceContentType struct {
 	p provider
 }
 
+func (r resourceContentType) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config ContentTypeData
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Schema.Null && len(config.Fields.Elems) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("schema"),
+			"Conflicting attributes",
+			"The schema attribute cannot be used together with field blocks.",
+		)
+	}
+
+	schema, diags := newContentTypeSchema(config.Fields)
+	resp.Diagnostics.Append(diags...)
+
+	// The referenced fields can only be checked when the complete schema is
+	// known.
+	if len(config.Fields.Elems) == 0 {
+		schema = json.RawMessage(config.Schema.Value)
+	}
+	if !config.Schema.Unknown && !config.Fields.Unknown && !hasUnknownElems(config.Fields) {
+		diags = validateContentTypeFieldRules(config.FieldRules, schema)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	for i, options := range config.Options {
+		if options.IsPage.Value || options.IsPage.Unknown {
+			continue
+		}
+
+		path := tftypes.NewAttributePath().WithAttributeName("options").WithElementKeyInt(i)
+		if !options.URLPattern.Null {
+			resp.Diagnostics.AddAttributeError(
+				path.WithAttributeName("url_pattern"),
+				"Unsupported attribute",
+				"The url_pattern attribute is only supported when is_page is true.",
+			)
+		}
+		if !options.URLPrefix.Null {
+			resp.Diagnostics.AddAttributeError(
+				path.WithAttributeName("url_prefix"),
+				"Unsupported attribute",
+				"The url_prefix attribute is only supported when is_page is true.",
+			)
+		}
+	}
+}
+
+func (r resourceContentType) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	// Nothing to compare when the content type is created or deleted.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state ContentTypeData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	var plan ContentTypeData
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AllowDestructiveChanges.Value || plan.AllowDestructiveChanges.Unknown {
+		return
+	}
+
+	// The changes can only be determined when the complete schema is known.
+	if plan.Schema.Unknown || plan.Fields.Unknown || hasUnknownElems(plan.Fields) {
+		return
+	}
+
+	prior, diags := NewContentTypeInput(&state)
+	resp.Diagnostics.Append(diags...)
+	planned, diags := NewContentTypeInput(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes, err := contentTypeDestructiveChanges(prior.Schema, planned.Schema)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to compare schemas", err.Error())
+		return
+	}
+
+	if len(changes) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("allow_destructive_changes"),
+			"Destructive changes",
+			fmt.Sprintf(
+				"The planned changes delete the data of the following fields in all entries of content type %s:\n\n  - %s\n\n"+
+					"Set allow_destructive_changes to true to apply these changes.",
+				state.UID.Value, strings.Join(changes, "\n  - ")),
+		)
+	}
+}
+
 func (r resourceContentType) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
 	var plan ContentTypeData
 	diags := req.Plan.Get(ctx, &plan)
@@ -75,7 +248,12 @@ func (r resourceContentType) Create(ctx context.Context, req tfsdk.CreateResourc
 		return
 	}
 
-	input := NewContentTypeInput(&plan)
+	input, diags := NewContentTypeInput(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resource, err := r.p.stack.ContentTypeCreate(ctx, *input)
 	if err != nil {
 		diags := processRemoteError(err)
@@ -104,10 +282,10 @@ func (r resourceContentType) Read(ctx context.Context, req tfsdk.ReadResourceReq
 	resource, err := r.p.stack.ContentTypeFetch(ctx, state.UID.Value)
 	if err != nil {
 		if IsNotFoundError(err) {
-			d := diag.NewErrorDiagnostic(
-				"Error retrieving global field",
-				fmt.Sprintf("The global field with UID %s was not found.", state.UID.Value))
-			resp.Diagnostics.Append(d)
+			resp.Diagnostics.AddWarning(
+				"Content type not found",
+				fmt.Sprintf("The content type with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
 		} else {
 			diags := processRemoteError(err)
 			resp.Diagnostics.Append(diags...)
@@ -115,12 +293,25 @@ func (r resourceContentType) Read(ctx context.Context, req tfsdk.ReadResourceReq
 		return
 	}
 
-	curr := NewContentTypeInput(&state)
+	curr, _ := NewContentTypeInput(&state)
 	diags = processResponse(resource, curr)
 	resp.Diagnostics.Append(diags...)
 
 	// Set state
 	newState := NewContentTypeData(resource)
+	if len(state.Fields.Elems) > 0 {
+		newState.Schema = JSONValue{Null: true}
+		newState.Fields, diags = newContentTypeFieldList(resource.Schema, state.Fields)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		newState.Schema = semanticJSONValue(state.Schema, newState.Schema)
+	}
+	newState.Options = mergeContentTypeOptions(newState.Options, state.Options)
+	newState.FieldRules = mergeContentTypeFieldRules(newState.FieldRules, state.FieldRules)
+	newState.AllowDestructiveChanges = state.AllowDestructiveChanges
 	diags = resp.State.Set(ctx, &newState)
 	resp.Diagnostics.Append(diags...)
 }
@@ -162,7 +353,24 @@ func (r resourceContentType) Update(ctx context.Context, req tfsdk.UpdateResourc
 		return
 	}
 
-	input := NewContentTypeInput(&plan)
+	input, diags := NewContentTypeInput(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An update replaces the complete content type, so the parts which are not
+	// managed are sent with their current values.
+	if input.Options == nil || input.FieldRules == nil {
+		current, err := r.p.stack.ContentTypeFetch(ctx, state.UID.Value)
+		if err != nil {
+			diags = processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		keepUnmanagedContentType(input, current)
+	}
+
 	resource, err := r.p.stack.ContentTypeUpdate(ctx, state.UID.Value, *input)
 	if err != nil {
 		diags = processRemoteError(err)
@@ -184,7 +392,7 @@ func (r resourceContentType) ImportState(ctx context.Context, req tfsdk.ImportRe
 	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
 }
 
-func NewContentTypeData(field *management.ContentType) *ContentTypeData {
+func NewContentTypeData(field *contentstack.ContentType) *ContentTypeData {
 
 	schemaContent, err := field.Schema.MarshalJSON()
 	if err != nil {
@@ -192,26 +400,134 @@ func NewContentTypeData(field *management.ContentType) *ContentTypeData {
 	}
 
 	state := &ContentTypeData{
-		UID:         types.String{Value: field.UID},
-		Title:       types.String{Value: field.Title},
-		Description: types.String{Value: field.Description},
-		Schema:      types.String{Value: string(schemaContent)},
+		UID:                     types.String{Value: field.UID},
+		Title:                   types.String{Value: field.Title},
+		Description:             types.String{Value: field.Description},
+		Schema:                  JSONValue{Value: string(schemaContent)},
+		AllowDestructiveChanges: types.Bool{Null: true},
+		Fields: types.List{
+			ElemType: contentTypeFieldObjectType(1),
+			Elems:    []attr.Value{},
+		},
+		Options: []ContentTypeOptionsData{},
+	}
+
+	// Field rules which can't be parsed are left out, so they show up as a
+	// difference in the plan.
+	state.FieldRules, _ = newContentTypeFieldRuleData(field.FieldRules)
+
+	if field.Options != nil {
+		subTitle := []types.String{}
+		for _, uid := range field.Options.SubTitle {
+			subTitle = append(subTitle, types.String{Value: uid})
+		}
+
+		state.Options = append(state.Options, ContentTypeOptionsData{
+			Singleton:  types.Bool{Value: field.Options.Singleton},
+			IsPage:     types.Bool{Value: field.Options.IsPage},
+			Title:      types.String{Value: field.Options.Title},
+			SubTitle:   subTitle,
+			URLPattern: types.String{Value: field.Options.UrlPattern},
+			URLPrefix:  types.String{Value: field.Options.UrlPrefix},
+		})
 	}
 	return state
 }
 
-func NewContentTypeInput(field *ContentTypeData) *management.ContentTypeInput {
+func NewContentTypeInput(field *ContentTypeData) (*contentstack.ContentTypeInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-	input := &management.ContentTypeInput{
+	input := &contentstack.ContentTypeInput{
 		UID:         &field.UID.Value,
 		Title:       &field.Title.Value,
 		Description: &field.Description.Value,
 		Schema:      json.RawMessage(field.Schema.Value),
 	}
 
-	return input
+	if len(field.Fields.Elems) > 0 {
+		input.Schema, diags = newContentTypeSchema(field.Fields)
+	}
+
+	fieldRules, d := newContentTypeFieldRules(field.FieldRules)
+	diags.Append(d...)
+	input.FieldRules = fieldRules
+
+	if len(field.Options) > 0 {
+		options := field.Options[0]
+		subTitle := []string{}
+		for _, uid := range options.SubTitle {
+			subTitle = append(subTitle, uid.Value)
+		}
+
+		input.Options = &management.ContentTypeOptions{
+			Singleton:  options.Singleton.Value,
+			IsPage:     options.IsPage.Value,
+			Title:      stringWithDefault(options.Title.Value, "title"),
+			SubTitle:   subTitle,
+			UrlPattern: options.URLPattern.Value,
+			UrlPrefix:  options.URLPrefix.Value,
+		}
+	}
+
+	return input, diags
+}
+
+// keepUnmanagedContentType copies the parts of the current content type which
+// are not set in the input, so they are left untouched by the update.
+func keepUnmanagedContentType(input *contentstack.ContentTypeInput, current *contentstack.ContentType) {
+	if input.Options == nil {
+		input.Options = current.Options
+	}
+	if input.FieldRules == nil {
+		input.FieldRules = current.FieldRules
+	}
 }
 
 func MergeContentType(out *ContentTypeData, in *ContentTypeData) {
 	out.Schema = in.Schema
+	out.Fields = in.Fields
+	out.AllowDestructiveChanges = in.AllowDestructiveChanges
+	out.Options = mergeContentTypeOptions(out.Options, in.Options)
+	out.FieldRules = mergeContentTypeFieldRules(out.FieldRules, in.FieldRules)
+}
+
+// mergeContentTypeFieldRules returns the field rules as returned by
+// Contentstack. The field rules are not managed when there are no prior
+// field_rule blocks.
+func mergeContentTypeFieldRules(current, prior []ContentTypeFieldRuleData) []ContentTypeFieldRuleData {
+	if len(prior) == 0 {
+		return []ContentTypeFieldRuleData{}
+	}
+	return current
+}
+
+// mergeContentTypeOptions returns the options as returned by Contentstack,
+// keeping the values which were not set explicitly null when they have their
+// default value. The options are not managed when there is no prior options
+// block.
+func mergeContentTypeOptions(current, prior []ContentTypeOptionsData) []ContentTypeOptionsData {
+	if len(prior) == 0 || len(current) == 0 {
+		return []ContentTypeOptionsData{}
+	}
+
+	out, in := current[0], prior[0]
+	if in.Singleton.Null && !out.Singleton.Value {
+		out.Singleton = in.Singleton
+	}
+	if in.IsPage.Null && !out.IsPage.Value {
+		out.IsPage = in.IsPage
+	}
+	if in.Title.Null && stringWithDefault(out.Title.Value, "title") == "title" {
+		out.Title = in.Title
+	}
+	if in.SubTitle == nil && len(out.SubTitle) == 0 {
+		out.SubTitle = in.SubTitle
+	}
+	if in.URLPattern.Null && out.URLPattern.Value == "" {
+		out.URLPattern = in.URLPattern
+	}
+	if in.URLPrefix.Null && out.URLPrefix.Value == "" {
+		out.URLPrefix = in.URLPrefix
+	}
+	return []ContentTypeOptionsData{out}
 }
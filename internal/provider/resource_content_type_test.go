@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/contentstack-go-sdk/management"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestContentTypeOptionsRoundTrip(t *testing.T) {
+	plan := &ContentTypeData{
+		UID:   types.String{Value: "page"},
+		Title: types.String{Value: "Page"},
+		Options: []ContentTypeOptionsData{
+			{
+				Singleton:  types.Bool{Null: true},
+				IsPage:     types.Bool{Value: true},
+				Title:      types.String{Null: true},
+				URLPattern: types.String{Value: "/:title"},
+				URLPrefix:  types.String{Value: "/pages/"},
+			},
+		},
+	}
+
+	input, diags := NewContentTypeInput(plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, &management.ContentTypeOptions{
+		IsPage:     true,
+		Title:      "title",
+		SubTitle:   []string{},
+		UrlPattern: "/:title",
+		UrlPrefix:  "/pages/",
+	}, input.Options)
+
+	state := NewContentTypeData(&contentstack.ContentType{
+		ContentType: management.ContentType{
+			UID:     "page",
+			Title:   "Page",
+			Options: input.Options,
+		},
+	})
+	MergeContentType(state, plan)
+	assert.Equal(t, plan.Options, state.Options)
+}
+
+func TestContentTypeOptionsUnmanaged(t *testing.T) {
+	plan := &ContentTypeData{
+		UID:     types.String{Value: "page"},
+		Title:   types.String{Value: "Page"},
+		Options: []ContentTypeOptionsData{},
+	}
+
+	input, diags := NewContentTypeInput(plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.Nil(t, input.Options)
+
+	current := &contentstack.ContentType{
+		ContentType: management.ContentType{
+			UID:   "page",
+			Title: "Page",
+			Options: &management.ContentTypeOptions{
+				Singleton: true,
+				Title:     "title",
+			},
+		},
+	}
+
+	// The update sends the current options, so they are not reset.
+	keepUnmanagedContentType(input, current)
+	assert.Equal(t, current.Options, input.Options)
+
+	state := NewContentTypeData(current)
+	MergeContentType(state, plan)
+	assert.Empty(t, state.Options)
+}
@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+type resourceDeliveryTokenType struct{}
+
+type DeliveryTokenData struct {
+	UID           types.String   `tfsdk:"uid"`
+	Name          types.String   `tfsdk:"name"`
+	Description   types.String   `tfsdk:"description"`
+	Environments  []types.String `tfsdk:"environments"`
+	Branches      []types.String `tfsdk:"branches"`
+	BranchAliases []types.String `tfsdk:"branch_aliases"`
+	Token         types.String   `tfsdk:"token"`
+}
+
+// Delivery Token Resource schema
+func (r resourceDeliveryTokenType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		Delivery tokens provide read-only access to the published content of
+		the associated environments. They are used by websites and apps to
+		fetch content with the Content Delivery API.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"environments": {
+				Type:        types.ListType{ElemType: types.StringType},
+				Required:    true,
+				Description: "The names of the environments the token gives access to.",
+			},
+			"branches": {
+				Type:        types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The UIDs of the branches the token gives access to. Defaults to the main branch.",
+			},
+			"branch_aliases": {
+				Type:        types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The UIDs of the branch aliases the token gives access to.",
+			},
+			"token": {
+				Type:        types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated delivery token.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceDeliveryTokenType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceDeliveryToken{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceDeliveryToken struct {
+	p provider
+}
+
+func (r resourceDeliveryToken) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan DeliveryTokenData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := NewDeliveryTokenInput(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.p.stack.DeliveryTokenCreate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state, diags := NewDeliveryTokenData(token, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceDeliveryToken) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state DeliveryTokenData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.p.stack.DeliveryTokenFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Delivery token not found",
+				fmt.Sprintf("The delivery token with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState, diags := NewDeliveryTokenData(token, &state)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceDeliveryToken) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state DeliveryTokenData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete token by calling API
+	err := r.p.stack.DeliveryTokenDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceDeliveryToken) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan DeliveryTokenData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state DeliveryTokenData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := NewDeliveryTokenInput(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.p.stack.DeliveryTokenUpdate(ctx, state.UID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	result, diags := NewDeliveryTokenData(token, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceDeliveryToken) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+// tokenScope is an item of the scope of a token in the JSON representation.
+// The scope defines which modules of the stack the token gives access to.
+type tokenScope struct {
+	Module        string          `json:"module"`
+	Environments  tokenScopeNames `json:"environments,omitempty"`
+	Branches      tokenScopeNames `json:"branches,omitempty"`
+	BranchAliases tokenScopeNames `json:"branch_aliases,omitempty"`
+	ACL           map[string]bool `json:"acl"`
+}
+
+// tokenScopeNames are the targets of a token scope. Contentstack accepts
+// names when creating a token, but returns the complete objects for some
+// modules. Only the name (or UID when there is no name) is kept.
+type tokenScopeNames []string
+
+func (n *tokenScopeNames) UnmarshalJSON(data []byte) error {
+	var values []json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	result := tokenScopeNames{}
+	for _, value := range values {
+		var name string
+		if err := json.Unmarshal(value, &name); err == nil {
+			result = append(result, name)
+			continue
+		}
+
+		var obj struct {
+			Name string `json:"name"`
+			UID  string `json:"uid"`
+		}
+		if err := json.Unmarshal(value, &obj); err != nil {
+			return err
+		}
+		result = append(result, stringWithDefault(obj.Name, obj.UID))
+	}
+	*n = result
+	return nil
+}
+
+// findTokenScope returns the scope item for the given module, or nil when
+// there is none.
+func findTokenScope(scope []tokenScope, module string) *tokenScope {
+	for i := range scope {
+		if scope[i].Module == module {
+			return &scope[i]
+		}
+	}
+	return nil
+}
+
+func NewDeliveryTokenData(token *contentstack.DeliveryToken, prior *DeliveryTokenData) (*DeliveryTokenData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	state := &DeliveryTokenData{
+		UID:          types.String{Value: token.UID},
+		Name:         types.String{Value: token.Name},
+		Description:  types.String{Value: token.Description},
+		Environments: []types.String{},
+		Token:        types.String{Value: token.Token},
+	}
+	if token.Description == "" && prior.Description.Null {
+		state.Description = prior.Description
+	}
+
+	scope := []tokenScope{}
+	if len(token.Scope) > 0 {
+		if err := json.Unmarshal(token.Scope, &scope); err != nil {
+			diags.AddError("Unable to parse scope", err.Error())
+			return state, diags
+		}
+	}
+
+	if s := findTokenScope(scope, "environment"); s != nil {
+		for _, name := range s.Environments {
+			state.Environments = append(state.Environments, types.String{Value: name})
+		}
+	}
+
+	// The main branch is added by Contentstack when no branches are given,
+	// and branches are not returned at all when they are not part of the
+	// plan of the organization.
+	if s := findTokenScope(scope, "branch"); s != nil {
+		isDefault := len(s.Branches) == 1 && s.Branches[0] == "main"
+		if prior.Branches != nil || !isDefault {
+			state.Branches = []types.String{}
+			for _, name := range s.Branches {
+				state.Branches = append(state.Branches, types.String{Value: name})
+			}
+		}
+	} else {
+		state.Branches = prior.Branches
+	}
+
+	if s := findTokenScope(scope, "branch_alias"); s != nil {
+		state.BranchAliases = []types.String{}
+		for _, name := range s.BranchAliases {
+			state.BranchAliases = append(state.BranchAliases, types.String{Value: name})
+		}
+	} else {
+		state.BranchAliases = prior.BranchAliases
+	}
+	return state, diags
+}
+
+func NewDeliveryTokenInput(token *DeliveryTokenData) (*contentstack.DeliveryTokenInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	environments := tokenScopeNames{}
+	for _, name := range token.Environments {
+		environments = append(environments, name.Value)
+	}
+
+	scope := []tokenScope{
+		{
+			Module:       "environment",
+			Environments: environments,
+			ACL:          map[string]bool{"read": true},
+		},
+	}
+
+	if token.Branches != nil {
+		branches := tokenScopeNames{}
+		for _, name := range token.Branches {
+			branches = append(branches, name.Value)
+		}
+		scope = append(scope, tokenScope{
+			Module:   "branch",
+			Branches: branches,
+			ACL:      map[string]bool{"read": true},
+		})
+	}
+
+	if token.BranchAliases != nil {
+		aliases := tokenScopeNames{}
+		for _, name := range token.BranchAliases {
+			aliases = append(aliases, name.Value)
+		}
+		scope = append(scope, tokenScope{
+			Module:        "branch_alias",
+			BranchAliases: aliases,
+			ACL:           map[string]bool{"read": true},
+		})
+	}
+
+	data, err := json.Marshal(scope)
+	if err != nil {
+		diags.AddError("Unable to serialize scope", err.Error())
+		return nil, diags
+	}
+
+	input := &contentstack.DeliveryTokenInput{
+		Name:        token.Name.Value,
+		Description: token.Description.Value,
+		Scope:       data,
+	}
+	return input, diags
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestDeliveryTokenScope(t *testing.T) {
+	plan := &DeliveryTokenData{
+		Name:         types.String{Value: "Website"},
+		Description:  types.String{Null: true},
+		Environments: []types.String{{Value: "production"}},
+	}
+
+	input, diags := NewDeliveryTokenInput(plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.JSONEq(t, `[{"module": "environment", "environments": ["production"], "acl": {"read": true}}]`, string(input.Scope))
+
+	// Contentstack returns the environments as objects and adds the main
+	// branch.
+	scope := `[
+		{"module": "environment", "environments": [{"name": "production", "uid": "blt123"}], "acl": {"read": true}},
+		{"module": "branch", "branches": ["main"], "acl": {"read": true}}
+	]`
+	state, diags := NewDeliveryTokenData(&contentstack.DeliveryToken{
+		UID:   "token",
+		Name:  "Website",
+		Token: "cs123",
+		Scope: json.RawMessage(scope),
+	}, plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, plan.Environments, state.Environments)
+	assert.Nil(t, state.Branches)
+	assert.True(t, state.Description.Null)
+	assert.Equal(t, "cs123", state.Token.Value)
+
+	plan.Branches = []types.String{{Value: "main"}}
+	state, diags = NewDeliveryTokenData(&contentstack.DeliveryToken{
+		UID:   "token",
+		Name:  "Website",
+		Scope: json.RawMessage(scope),
+	}, plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, plan.Branches, state.Branches)
+}
+
+func TestDeliveryTokenBranchAliases(t *testing.T) {
+	plan := &DeliveryTokenData{
+		Name:          types.String{Value: "Website"},
+		Description:   types.String{Null: true},
+		Environments:  []types.String{{Value: "production"}},
+		BranchAliases: []types.String{{Value: "live"}},
+	}
+
+	input, diags := NewDeliveryTokenInput(plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.JSONEq(t, `[
+		{"module": "environment", "environments": ["production"], "acl": {"read": true}},
+		{"module": "branch_alias", "branch_aliases": ["live"], "acl": {"read": true}}
+	]`, string(input.Scope))
+
+	scope := `[
+		{"module": "environment", "environments": ["production"], "acl": {"read": true}},
+		{"module": "branch", "branches": ["main"], "acl": {"read": true}},
+		{"module": "branch_alias", "branch_aliases": [{"uid": "live"}], "acl": {"read": true}}
+	]`
+	state, diags := NewDeliveryTokenData(&contentstack.DeliveryToken{
+		UID:   "token",
+		Name:  "Website",
+		Scope: json.RawMessage(scope),
+	}, plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.Nil(t, state.Branches)
+	assert.Equal(t, plan.BranchAliases, state.BranchAliases)
+}
@@ -0,0 +1,545 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/contentstack-go-sdk/management"
+)
+
+// entrySystemKeys are the keys of an entry which are managed by Contentstack.
+// They are never part of the fields of the resource.
+var entrySystemKeys = map[string]bool{
+	"uid":             true,
+	"locale":          true,
+	"_version":        true,
+	"_in_progress":    true,
+	"_metadata":       true,
+	"_workflow":       true,
+	"ACL":             true,
+	"created_at":      true,
+	"created_by":      true,
+	"updated_at":      true,
+	"updated_by":      true,
+	"publish_details": true,
+}
+
+type resourceEntryType struct{}
+
+type EntryData struct {
+	UID            types.String            `tfsdk:"uid"`
+	ContentTypeUID types.String            `tfsdk:"content_type_uid"`
+	Locale         types.String            `tfsdk:"locale"`
+	Fields         JSONValue               `tfsdk:"fields"`
+	IgnoreKeys     []types.String          `tfsdk:"ignore_keys"`
+	Localizations  []EntryLocalizationData `tfsdk:"localization"`
+	Version        types.Int64             `tfsdk:"version"`
+}
+
+type EntryLocalizationData struct {
+	Locale types.String `tfsdk:"locale"`
+	Fields JSONValue    `tfsdk:"fields"`
+}
+
+// Entry Resource schema
+func (r resourceEntryType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		An entry of a content type. This resource is meant for entries which
+		are effectively configuration, such as site settings or navigation.
+		Only the fields in the configuration are managed, other fields are left
+		as they are when the entry is updated. Fields which are maintained by
+		editors can be excluded from the state with ignore_keys.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"content_type_uid": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The UID of the content type of the entry.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"locale": {
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "The code of the locale in which the entry is created. Defaults to the master locale of the stack.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"fields": {
+				Type:        jsonType{},
+				Required:    true,
+				Description: "The values of the fields as JSON object. Differences in formatting, key order and empty values are ignored.",
+			},
+			"ignore_keys": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The fields which are maintained by editors. These fields are kept as they are when the entry is updated.",
+			},
+			"version": {
+				Type:        types.Int64Type,
+				Computed:    true,
+				Description: "The version of the entry in the master locale.",
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"localization": {
+				NestingMode: tfsdk.BlockNestingModeList,
+				Description: "The localized versions of the entry. The entry is unlocalized in locales which are removed.",
+				Attributes: map[string]tfsdk.Attribute{
+					"locale": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The code of the locale.",
+					},
+					"fields": {
+						Type:        jsonType{},
+						Required:    true,
+						Description: "The values of the localized fields as JSON object.",
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceEntryType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceEntry{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceEntry struct {
+	p provider
+}
+
+func (r resourceEntry) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config EntryData
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = validateEntryFields(config.Fields, config.IgnoreKeys, tftypes.NewAttributePath().WithAttributeName("fields"))
+	resp.Diagnostics.Append(diags...)
+
+	locales := map[string]bool{}
+	for i, localization := range config.Localizations {
+		path := tftypes.NewAttributePath().WithAttributeName("localization").WithElementKeyInt(i)
+		diags = validateEntryFields(localization.Fields, config.IgnoreKeys, path.WithAttributeName("fields"))
+		resp.Diagnostics.Append(diags...)
+
+		if localization.Locale.Unknown {
+			continue
+		}
+		if locales[localization.Locale.Value] || localization.Locale.Equal(config.Locale) {
+			resp.Diagnostics.AddAttributeError(
+				path.WithAttributeName("locale"),
+				"Duplicate locale",
+				fmt.Sprintf("The entry is already defined for locale %s.", localization.Locale.Value),
+			)
+		}
+		locales[localization.Locale.Value] = true
+	}
+}
+
+// validateEntryFields checks that the fields are a JSON object which doesn't
+// contain system keys or ignored keys.
+func validateEntryFields(fields JSONValue, ignoreKeys []types.String, path *tftypes.AttributePath) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if fields.Null || fields.Unknown {
+		return diags
+	}
+
+	values := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(fields.Value), &values); err != nil {
+		diags.AddAttributeError(path, "Invalid fields", "The fields should be a JSON object.")
+		return diags
+	}
+
+	for key := range values {
+		if entrySystemKeys[key] {
+			diags.AddAttributeError(
+				path,
+				"Invalid fields",
+				fmt.Sprintf("The key %s is managed by Contentstack and cannot be set.", key),
+			)
+		}
+	}
+	for _, key := range ignoreKeys {
+		if _, ok := values[key.Value]; ok {
+			diags.AddAttributeError(
+				path,
+				"Invalid fields",
+				fmt.Sprintf("The key %s is ignored and cannot be set.", key.Value),
+			)
+		}
+	}
+	return diags
+}
+
+func (r resourceEntry) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan EntryData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := NewEntryInput(plan.Fields, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.ContentTypeUID = plan.ContentTypeUID.Value
+	input.Locale = plan.Locale.Value
+
+	entry, err := r.p.stack.EntryCreate(ctx, input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	state, diags := NewEntryData(entry, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	// Write the entry to the state before localizing it, so the entry isn't
+	// lost when localizing fails.
+	localizations := state.Localizations
+	state.Localizations = []EntryLocalizationData{}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Localizations, diags = r.localize(ctx, state, localizations)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceEntry) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state EntryData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, err := r.p.stack.EntryFetch(ctx, &management.EntryContextInput{
+		ContentTypeUID: state.ContentTypeUID.Value,
+		Locale:         state.Locale.Value,
+		UID:            state.UID.Value,
+	})
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Entry not found",
+				fmt.Sprintf("The entry with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	newState, diags := NewEntryData(entry, &state)
+	resp.Diagnostics.Append(diags...)
+
+	newState.Localizations = []EntryLocalizationData{}
+	for _, prior := range state.Localizations {
+		localized, err := r.p.stack.EntryFetch(ctx, &management.EntryContextInput{
+			ContentTypeUID: state.ContentTypeUID.Value,
+			Locale:         prior.Locale.Value,
+			UID:            state.UID.Value,
+		})
+		if err != nil {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		// Contentstack falls back to the master locale when the entry is
+		// not localized.
+		if localized.Locale != prior.Locale.Value {
+			continue
+		}
+
+		fields, diags := newEntryFields(localized.Fields, prior.Fields, state.IgnoreKeys)
+		resp.Diagnostics.Append(diags...)
+		newState.Localizations = append(newState.Localizations, EntryLocalizationData{
+			Locale: prior.Locale,
+			Fields: fields,
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceEntry) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state EntryData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete entry, including the localizations, by calling API
+	err := r.p.stack.EntryDelete(ctx, &management.EntryContextInput{
+		ContentTypeUID: state.ContentTypeUID.Value,
+		UID:            state.UID.Value,
+	})
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceEntry) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan EntryData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state EntryData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.UID = state.UID
+	plan.Locale = state.Locale
+	entry, diags := r.update(ctx, &plan, state.Locale.Value, plan.Fields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := NewEntryData(entry, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	// Remove the localizations which are no longer defined.
+	for _, localization := range state.Localizations {
+		if findEntryLocalization(plan.Localizations, localization.Locale.Value) != nil {
+			continue
+		}
+		err := r.p.stack.EntryUnlocalize(ctx, &management.EntryContextInput{
+			ContentTypeUID: state.ContentTypeUID.Value,
+			Locale:         localization.Locale.Value,
+			UID:            state.UID.Value,
+		})
+		if err != nil && !IsNotFoundError(err) {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+			result.Localizations = state.Localizations
+			diags = resp.State.Set(ctx, result)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	result.Localizations, diags = r.localize(ctx, result, plan.Localizations)
+	resp.Diagnostics.Append(diags...)
+
+	// Set state
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports an entry with an ID in the format
+// <content_type_uid>/<uid>.
+func (r resourceEntry) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("The import ID should be in the format <content_type_uid>/<uid>, got %q.", req.ID),
+		)
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("content_type_uid"), parts[0])
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), parts[1])
+	resp.Diagnostics.Append(diags...)
+}
+
+// update updates the entry in the given locale. The current values of the
+// ignored keys are fetched first, so these are kept as they are.
+func (r resourceEntry) update(ctx context.Context, plan *EntryData, locale string, fields JSONValue) (*management.Entry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// An update replaces all fields of the entry, so the current fields are
+	// needed to keep the fields which are not managed.
+	current, err := r.p.stack.EntryFetch(ctx, &management.EntryContextInput{
+		ContentTypeUID: plan.ContentTypeUID.Value,
+		Locale:         locale,
+		UID:            plan.UID.Value,
+	})
+	if err != nil {
+		return nil, processRemoteError(err)
+	}
+
+	input, d := NewEntryInput(fields, current.Fields)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	input.ContentTypeUID = plan.ContentTypeUID.Value
+	input.Locale = locale
+
+	entry, err := r.p.stack.EntryUpdate(ctx, plan.UID.Value, input)
+	if err != nil {
+		diags.Append(processRemoteError(err)...)
+		return nil, diags
+	}
+	return entry, diags
+}
+
+// localize creates or updates the localized versions of the entry. The
+// localizations which succeeded are returned.
+func (r resourceEntry) localize(ctx context.Context, plan *EntryData, localizations []EntryLocalizationData) ([]EntryLocalizationData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := []EntryLocalizationData{}
+	for _, localization := range localizations {
+		entry, d := r.update(ctx, plan, localization.Locale.Value, localization.Fields)
+		diags.Append(d...)
+		if diags.HasError() {
+			return result, diags
+		}
+
+		fields, d := newEntryFields(entry.Fields, localization.Fields, plan.IgnoreKeys)
+		diags.Append(d...)
+		result = append(result, EntryLocalizationData{
+			Locale: localization.Locale,
+			Fields: fields,
+		})
+	}
+	return result, diags
+}
+
+func findEntryLocalization(localizations []EntryLocalizationData, locale string) *EntryLocalizationData {
+	for i := range localizations {
+		if localizations[i].Locale.Value == locale {
+			return &localizations[i]
+		}
+	}
+	return nil
+}
+
+// newEntryFields returns the fields of the entry as JSON value. Only the keys
+// in the prior value are kept, so fields which are not managed by the
+// resource don't show up as changes. All fields except for the system keys
+// and ignored keys are kept when there is no prior value, i.e. after an
+// import.
+func newEntryFields(values map[string]interface{}, prior JSONValue, ignoreKeys []types.String) (JSONValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var keys map[string]json.RawMessage
+	if !prior.Null && !prior.Unknown {
+		if err := json.Unmarshal([]byte(prior.Value), &keys); err != nil {
+			diags.AddError("Unable to parse fields", err.Error())
+			return prior, diags
+		}
+	}
+
+	ignored := map[string]bool{}
+	for _, key := range ignoreKeys {
+		ignored[key.Value] = true
+	}
+
+	fields := map[string]interface{}{}
+	for key, value := range values {
+		if keys != nil {
+			if _, ok := keys[key]; !ok {
+				continue
+			}
+		}
+		if entrySystemKeys[key] || ignored[key] {
+			continue
+		}
+		fields[key] = value
+	}
+
+	content, err := json.Marshal(fields)
+	if err != nil {
+		diags.AddError("Unable to serialize fields", err.Error())
+		return prior, diags
+	}
+	return semanticJSONValue(prior, JSONValue{Value: string(content)}), diags
+}
+
+func NewEntryData(entry *management.Entry, prior *EntryData) (*EntryData, diag.Diagnostics) {
+	fields, diags := newEntryFields(entry.Fields, prior.Fields, prior.IgnoreKeys)
+
+	state := &EntryData{
+		UID:            types.String{Value: entry.UID},
+		ContentTypeUID: prior.ContentTypeUID,
+		Locale:         types.String{Value: entry.Locale},
+		Fields:         fields,
+		IgnoreKeys:     prior.IgnoreKeys,
+		Localizations:  prior.Localizations,
+		Version:        types.Int64{Value: int64(entry.Version)},
+	}
+	return state, diags
+}
+
+// NewEntryInput converts the fields to the input for Contentstack. The
+// values of the fields which are not configured, including the ignored keys,
+// are copied from the current fields. The content type and locale of the
+// input are not set.
+func NewEntryInput(fields JSONValue, current map[string]interface{}) (*management.EntryInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(fields.Value), &values); err != nil {
+		diags.AddError("Unable to parse fields", err.Error())
+		return nil, diags
+	}
+
+	for key, value := range current {
+		if _, ok := values[key]; ok || entrySystemKeys[key] {
+			continue
+		}
+		values[key] = value
+	}
+
+	input := &management.EntryInput{
+		Fields: values,
+	}
+	return input, diags
+}
@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/contentstack-go-sdk/management"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+type resourceEntryPublishType struct{}
+
+type EntryPublishData struct {
+	ContentTypeUID types.String   `tfsdk:"content_type_uid"`
+	EntryUID       types.String   `tfsdk:"entry_uid"`
+	Version        types.Int64    `tfsdk:"version"`
+	Environments   []types.String `tfsdk:"environments"`
+	Locales        []types.String `tfsdk:"locales"`
+}
+
+// Entry Publish Resource schema
+func (r resourceEntryPublishType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		Publishes a version of an entry to the given environments and locales.
+		The entry is published again when the version changes, and unpublished
+		when the resource is destroyed.
+
+		Note: Publishing is processed asynchronously by Contentstack, the
+		resource doesn't wait for the entry to be published. The entry is
+		published again when it is unpublished or another version is
+		published outside of Terraform.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"content_type_uid": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The UID of the content type of the entry.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"entry_uid": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The UID of the entry.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"version": {
+				Type:        types.Int64Type,
+				Required:    true,
+				Description: "The version of the entry to publish.",
+			},
+			"environments": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Required:    true,
+				Description: "The names of the environments to publish the entry to.",
+			},
+			"locales": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Required:    true,
+				Description: "The codes of the locales to publish the entry in.",
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceEntryPublishType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceEntryPublish{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceEntryPublish struct {
+	p provider
+}
+
+func (r resourceEntryPublish) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan EntryPublishData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := NewEntryPublishInput(&plan)
+	err := r.p.stack.EntryPublish(ctx, plan.ContentTypeUID.Value, plan.EntryUID.Value, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read compares the publish details of the entry with the state. The
+// environments and locales in which the entry is no longer published are
+// removed from the state, and a different published version is set as the
+// version, so the entry is published again.
+func (r resourceEntryPublish) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state EntryPublishData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	details := []contentstack.EntryPublishDetail{}
+	for _, locale := range state.Locales {
+		result, err := r.p.stack.EntryPublishDetails(ctx, &management.EntryContextInput{
+			ContentTypeUID: state.ContentTypeUID.Value,
+			Locale:         locale.Value,
+			UID:            state.EntryUID.Value,
+		})
+		if err != nil {
+			if IsNotFoundError(err) {
+				resp.Diagnostics.AddWarning(
+					"Entry not found",
+					fmt.Sprintf("The entry with UID %s was not found, removing it from the state.", state.EntryUID.Value))
+				resp.State.RemoveResource(ctx)
+			} else {
+				diags := processRemoteError(err)
+				resp.Diagnostics.Append(diags...)
+			}
+			return
+		}
+		details = append(details, result...)
+	}
+
+	// The publish details refer to the environments by UID.
+	environmentUIDs := map[string]string{}
+	for _, name := range state.Environments {
+		environment, err := r.p.stack.EnvironmentFetch(ctx, name.Value)
+		if err != nil {
+			if IsNotFoundError(err) {
+				continue
+			}
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		environmentUIDs[name.Value] = environment.UID
+	}
+
+	// Set state
+	newState := NewEntryPublishData(details, environmentUIDs, &state)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceEntryPublish) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state EntryPublishData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unpublish entry by calling API, nothing to unpublish when the entry
+	// is already deleted.
+	input := NewEntryPublishInput(&state)
+	err := r.p.stack.EntryUnpublish(ctx, state.ContentTypeUID.Value, state.EntryUID.Value, *input)
+	if err != nil && !IsNotFoundError(err) {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceEntryPublish) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan EntryPublishData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state EntryPublishData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, input := range entryUnpublishInputs(&state, &plan) {
+		err := r.p.stack.EntryUnpublish(ctx, state.ContentTypeUID.Value, state.EntryUID.Value, input)
+		if err != nil {
+			diags = processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	input := NewEntryPublishInput(&plan)
+	err := r.p.stack.EntryPublish(ctx, plan.ContentTypeUID.Value, plan.EntryUID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// entryUnpublishInputs returns the inputs to unpublish the entry from the
+// environments and locales which are no longer part of the plan.
+func entryUnpublishInputs(state, plan *EntryPublishData) []contentstack.EntryPublishInput {
+	result := []contentstack.EntryPublishInput{}
+
+	var removedEnvironments, keptEnvironments []string
+	for _, environment := range state.Environments {
+		if containsStringValue(plan.Environments, environment.Value) {
+			keptEnvironments = append(keptEnvironments, environment.Value)
+		} else {
+			removedEnvironments = append(removedEnvironments, environment.Value)
+		}
+	}
+
+	var removedLocales []string
+	for _, locale := range state.Locales {
+		if !containsStringValue(plan.Locales, locale.Value) {
+			removedLocales = append(removedLocales, locale.Value)
+		}
+	}
+
+	// Unpublish all locales from the removed environments, and the removed
+	// locales from the other environments.
+	if len(removedEnvironments) > 0 {
+		input := NewEntryPublishInput(state)
+		input.Environments = removedEnvironments
+		result = append(result, *input)
+	}
+	if len(removedLocales) > 0 && len(keptEnvironments) > 0 {
+		input := NewEntryPublishInput(state)
+		input.Environments = keptEnvironments
+		input.Locales = removedLocales
+		result = append(result, *input)
+	}
+	return result
+}
+
+func containsStringValue(values []types.String, value string) bool {
+	for _, v := range values {
+		if v.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// NewEntryPublishData returns the prior state updated with the publish
+// details. Environments are only kept when the entry is published in all
+// locales of the prior state, and locales when the entry is published in all
+// kept environments.
+func NewEntryPublishData(details []contentstack.EntryPublishDetail, environmentUIDs map[string]string, prior *EntryPublishData) *EntryPublishData {
+	versions := map[string]int64{}
+	for _, detail := range details {
+		versions[detail.Environment+"/"+detail.Locale] = detail.Version
+	}
+	published := func(environment, locale types.String) (int64, bool) {
+		uid, ok := environmentUIDs[environment.Value]
+		if !ok {
+			return 0, false
+		}
+		version, ok := versions[uid+"/"+locale.Value]
+		return version, ok
+	}
+
+	state := *prior
+	state.Environments = []types.String{}
+	for _, environment := range prior.Environments {
+		complete := true
+		for _, locale := range prior.Locales {
+			if _, ok := published(environment, locale); !ok {
+				complete = false
+			}
+		}
+		if complete {
+			state.Environments = append(state.Environments, environment)
+		}
+	}
+
+	state.Locales = []types.String{}
+	for _, locale := range prior.Locales {
+		complete := true
+		for _, environment := range state.Environments {
+			version, ok := published(environment, locale)
+			if !ok {
+				complete = false
+				continue
+			}
+			if version != prior.Version.Value {
+				state.Version = types.Int64{Value: version}
+			}
+		}
+		if complete {
+			state.Locales = append(state.Locales, locale)
+		}
+	}
+	return &state
+}
+
+func NewEntryPublishInput(publish *EntryPublishData) *contentstack.EntryPublishInput {
+	input := &contentstack.EntryPublishInput{
+		Environments: []string{},
+		Locales:      []string{},
+		Version:      publish.Version.Value,
+	}
+	for _, name := range publish.Environments {
+		input.Environments = append(input.Environments, name.Value)
+	}
+	for _, code := range publish.Locales {
+		input.Locales = append(input.Locales, code.Value)
+	}
+	return input
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestEntryUnpublishInputs(t *testing.T) {
+	state := &EntryPublishData{
+		ContentTypeUID: types.String{Value: "site_settings"},
+		EntryUID:       types.String{Value: "blt1"},
+		Version:        types.Int64{Value: 2},
+		Environments:   []types.String{{Value: "staging"}, {Value: "production"}},
+		Locales:        []types.String{{Value: "en-us"}, {Value: "nl-nl"}},
+	}
+
+	// Only publishing a new version doesn't unpublish anything.
+	plan := *state
+	plan.Version = types.Int64{Value: 3}
+	assert.Empty(t, entryUnpublishInputs(state, &plan))
+
+	plan.Environments = []types.String{{Value: "production"}}
+	plan.Locales = []types.String{{Value: "en-us"}, {Value: "de-de"}}
+	expected := []contentstack.EntryPublishInput{
+		{
+			Environments: []string{"staging"},
+			Locales:      []string{"en-us", "nl-nl"},
+			Version:      2,
+		},
+		{
+			Environments: []string{"production"},
+			Locales:      []string{"nl-nl"},
+			Version:      2,
+		},
+	}
+	assert.Equal(t, expected, entryUnpublishInputs(state, &plan))
+}
+
+func TestEntryPublishDrift(t *testing.T) {
+	prior := &EntryPublishData{
+		ContentTypeUID: types.String{Value: "site_settings"},
+		EntryUID:       types.String{Value: "blt1"},
+		Version:        types.Int64{Value: 2},
+		Environments:   []types.String{{Value: "staging"}, {Value: "production"}},
+		Locales:        []types.String{{Value: "en-us"}, {Value: "nl-nl"}},
+	}
+	environmentUIDs := map[string]string{"staging": "blt2", "production": "blt3"}
+	details := []contentstack.EntryPublishDetail{
+		{Environment: "blt2", Locale: "en-us", Version: 2},
+		{Environment: "blt2", Locale: "nl-nl", Version: 2},
+		{Environment: "blt3", Locale: "en-us", Version: 2},
+		{Environment: "blt3", Locale: "nl-nl", Version: 2},
+		{Environment: "blt4", Locale: "en-us", Version: 1},
+	}
+
+	// Environments which are not managed are ignored.
+	state := NewEntryPublishData(details, environmentUIDs, prior)
+	assert.Equal(t, prior, state)
+
+	// Another version published in the UI.
+	details[3].Version = 3
+	state = NewEntryPublishData(details, environmentUIDs, prior)
+	assert.Equal(t, int64(3), state.Version.Value)
+	assert.Equal(t, prior.Environments, state.Environments)
+	assert.Equal(t, prior.Locales, state.Locales)
+
+	// Unpublished from production in one locale.
+	state = NewEntryPublishData(details[:3], environmentUIDs, prior)
+	assert.Equal(t, int64(2), state.Version.Value)
+	assert.Equal(t, []types.String{{Value: "staging"}}, state.Environments)
+	assert.Equal(t, prior.Locales, state.Locales)
+
+	// Not published at all, or the environment doesn't exist anymore.
+	state = NewEntryPublishData(details, map[string]string{}, prior)
+	assert.Empty(t, state.Environments)
+	assert.Equal(t, prior.Locales, state.Locales)
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEntryFields(t *testing.T) {
+	// The SDK already removes most of the system keys.
+	remote := map[string]interface{}{
+		"_metadata":        map[string]interface{}{"references": []interface{}{}},
+		"title":            "Settings",
+		"maintenance_mode": false,
+		"banner":           "Edited by an editor",
+		"footer":           map[string]interface{}{"copyright": "ACME"},
+	}
+	ignoreKeys := []types.String{{Value: "banner"}}
+
+	// Only the configured keys are kept, written as configured.
+	prior := JSONValue{Value: `{"title": "Settings", "footer": {"copyright": "ACME"}}`}
+	fields, diags := newEntryFields(remote, prior, ignoreKeys)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, prior, fields)
+
+	// Remote changes to the configured keys are detected.
+	prior = JSONValue{Value: `{"title": "Site settings"}`}
+	fields, diags = newEntryFields(remote, prior, ignoreKeys)
+	assert.False(t, diags.HasError(), diags)
+	assert.JSONEq(t, `{"title": "Settings"}`, fields.Value)
+
+	// All fields except system keys and ignored keys are kept after an
+	// import.
+	fields, diags = newEntryFields(remote, JSONValue{Null: true}, ignoreKeys)
+	assert.False(t, diags.HasError(), diags)
+	assert.JSONEq(t, `{"title": "Settings", "maintenance_mode": false, "footer": {"copyright": "ACME"}}`, fields.Value)
+}
+
+func TestNewEntryInput(t *testing.T) {
+	fields := JSONValue{Value: `{"title": "Settings", "maintenance_mode": true}`}
+	current := map[string]interface{}{
+		"_metadata":        map[string]interface{}{"references": []interface{}{}},
+		"title":            "Old settings",
+		"maintenance_mode": false,
+		"banner":           "Edited by an editor",
+		"footer":           map[string]interface{}{"copyright": "ACME"},
+	}
+
+	// The fields which are not configured are kept as they are.
+	input, diags := NewEntryInput(fields, current)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, map[string]interface{}{
+		"title":            "Settings",
+		"maintenance_mode": true,
+		"banner":           "Edited by an editor",
+		"footer":           map[string]interface{}{"copyright": "ACME"},
+	}, input.Fields)
+
+	input, diags = NewEntryInput(fields, nil)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, map[string]interface{}{
+		"title":            "Settings",
+		"maintenance_mode": true,
+	}, input.Fields)
+}
+
+func TestValidateEntryFields(t *testing.T) {
+	path := tftypes.NewAttributePath().WithAttributeName("fields")
+	ignoreKeys := []types.String{{Value: "banner"}}
+
+	diags := validateEntryFields(JSONValue{Value: `{"title": "Settings"}`}, ignoreKeys, path)
+	assert.False(t, diags.HasError(), diags)
+
+	diags = validateEntryFields(JSONValue{Value: `["title"]`}, ignoreKeys, path)
+	assert.True(t, diags.HasError())
+
+	diags = validateEntryFields(JSONValue{Value: `{"uid": "blt1", "banner": ""}`}, ignoreKeys, path)
+	assert.Len(t, diags, 2)
+}
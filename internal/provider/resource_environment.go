@@ -110,10 +110,10 @@ func (r resourceEnvironment) Read(ctx context.Context, req tfsdk.ReadResourceReq
 	environment, err := r.p.stack.EnvironmentFetch(ctx, state.Name.Value)
 	if err != nil {
 		if IsNotFoundError(err) {
-			d := diag.NewErrorDiagnostic(
-				"Error retrieving environment",
-				fmt.Sprintf("The environment with Name %s was not found.", state.Name.Value))
-			resp.Diagnostics.Append(d)
+			resp.Diagnostics.AddWarning(
+				"Environment not found",
+				fmt.Sprintf("The environment with name %s was not found, removing it from the state.", state.Name.Value))
+			resp.State.RemoveResource(ctx)
 		} else {
 			diags := processRemoteError(err)
 			resp.Diagnostics.Append(diags...)
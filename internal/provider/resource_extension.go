@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+var extensionTypes = []string{
+	"field",
+	"widget",
+	"dashboard",
+	"asset_sidebar_widget",
+	"rte_plugin",
+}
+
+// extensionDataTypes lists the data types of the values stored by custom
+// field extensions.
+var extensionDataTypes = []string{
+	"text",
+	"number",
+	"isodate",
+	"boolean",
+	"json",
+	"reference",
+	"file",
+}
+
+type resourceExtensionType struct{}
+
+type ExtensionData struct {
+	UID      types.String   `tfsdk:"uid"`
+	Title    types.String   `tfsdk:"title"`
+	Type     types.String   `tfsdk:"type"`
+	Src      types.String   `tfsdk:"src"`
+	Srcdoc   types.String   `tfsdk:"srcdoc"`
+	Config   JSONValue      `tfsdk:"config"`
+	DataType types.String   `tfsdk:"data_type"`
+	Multiple types.Bool     `tfsdk:"multiple"`
+	Tags     []types.String `tfsdk:"tags"`
+}
+
+// Extension Resource schema
+func (r resourceExtensionType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		Extensions add custom fields, widgets, dashboards, asset sidebar
+		widgets and rich text editor plugins to the Contentstack UI. Custom
+		fields are used in the schema of a content type by setting the
+		extension_uid of the field to the UID of the extension.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"title": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"type": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The type of the extension, one of " + strings.Join(extensionTypes, ", ") + ".",
+				Validators: []tfsdk.AttributeValidator{
+					oneOfValidator{values: extensionTypes},
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"src": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The URL of the hosted extension. Conflicts with `srcdoc`.",
+			},
+			"srcdoc": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The HTML source of the extension, hosted by Contentstack. Conflicts with `src`.",
+			},
+			"config": {
+				Type:        jsonType{},
+				Optional:    true,
+				Description: "The configuration of the extension as JSON. Differences in formatting and key order are ignored.",
+			},
+			"data_type": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The data type of the value stored by the field, one of " + strings.Join(extensionDataTypes, ", ") + ". Only for `field` extensions, for which it is required.",
+				Validators: []tfsdk.AttributeValidator{
+					oneOfValidator{values: extensionDataTypes},
+				},
+			},
+			"multiple": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Whether the field stores multiple values. Only for `field` extensions.",
+			},
+			"tags": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceExtensionType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceExtension{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceExtension struct {
+	p provider
+}
+
+func (r resourceExtension) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config ExtensionData
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Src.Null && !config.Srcdoc.Null {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("srcdoc"),
+			"Conflicting attributes",
+			"The srcdoc attribute cannot be used together with the src attribute.",
+		)
+	}
+	if config.Src.Null && config.Srcdoc.Null {
+		resp.Diagnostics.AddError(
+			"Missing source",
+			"Either the src or the srcdoc attribute is required.",
+		)
+	}
+
+	if config.Type.Unknown {
+		return
+	}
+	if config.Type.Value == "field" {
+		if config.DataType.Null {
+			resp.Diagnostics.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("data_type"),
+				"Missing data_type",
+				"The data_type attribute is required for field extensions.",
+			)
+		}
+		return
+	}
+	if !config.DataType.Null {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("data_type"),
+			"Unsupported attribute",
+			"The data_type attribute is only supported for field extensions.",
+		)
+	}
+	if !config.Multiple.Null {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("multiple"),
+			"Unsupported attribute",
+			"The multiple attribute is only supported for field extensions.",
+		)
+	}
+}
+
+func (r resourceExtension) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan ExtensionData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := NewExtensionInput(&plan)
+	extension, err := r.p.stack.ExtensionCreate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state := NewExtensionData(extension, &plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceExtension) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state ExtensionData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension, err := r.p.stack.ExtensionFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Extension not found",
+				fmt.Sprintf("The extension with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState := NewExtensionData(extension, &state)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceExtension) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state ExtensionData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete extension by calling API
+	err := r.p.stack.ExtensionDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceExtension) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan ExtensionData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state ExtensionData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := NewExtensionInput(&plan)
+	extension, err := r.p.stack.ExtensionUpdate(ctx, state.UID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	result := NewExtensionData(extension, &plan)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceExtension) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+func NewExtensionData(extension *contentstack.Extension, prior *ExtensionData) *ExtensionData {
+	state := &ExtensionData{
+		UID:      types.String{Value: extension.UID},
+		Title:    types.String{Value: extension.Title},
+		Type:     types.String{Value: extension.Type},
+		Src:      types.String{Value: extension.Src},
+		Srcdoc:   types.String{Value: extension.Srcdoc},
+		Config:   JSONValue{Null: true},
+		DataType: types.String{Value: extension.DataType},
+		Multiple: optionalBoolValue(extension.Multiple, prior.Multiple),
+	}
+	if extension.Src == "" && prior.Src.Null {
+		state.Src = prior.Src
+	}
+	if extension.Srcdoc == "" && prior.Srcdoc.Null {
+		state.Srcdoc = prior.Srcdoc
+	}
+	if extension.DataType == "" && prior.DataType.Null {
+		state.DataType = prior.DataType
+	}
+
+	// An empty configuration is returned when none is set.
+	if len(extension.Config) > 0 {
+		state.Config = plainJSONValue(prior.Config, JSONValue{Value: string(extension.Config)})
+	}
+	if prior.Config.Null && (len(extension.Config) == 0 || jsonPlainEqual(string(extension.Config), "{}")) {
+		state.Config = prior.Config
+	}
+
+	if len(extension.Tags) > 0 || prior.Tags != nil {
+		state.Tags = newStringList(extension.Tags)
+	}
+	return state
+}
+
+func NewExtensionInput(extension *ExtensionData) *contentstack.ExtensionInput {
+	input := &contentstack.ExtensionInput{
+		Title:    extension.Title.Value,
+		Type:     extension.Type.Value,
+		Src:      extension.Src.Value,
+		Srcdoc:   extension.Srcdoc.Value,
+		Config:   json.RawMessage("{}"),
+		DataType: extension.DataType.Value,
+		Multiple: extension.Multiple.Value,
+		Tags:     []string{},
+	}
+	if !extension.Config.Null && extension.Config.Value != "" {
+		input.Config = json.RawMessage(extension.Config.Value)
+	}
+	for _, tag := range extension.Tags {
+		input.Tags = append(input.Tags, tag.Value)
+	}
+	return input
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestExtensionConfig(t *testing.T) {
+	plan := &ExtensionData{
+		Title:    types.String{Value: "Color picker"},
+		Type:     types.String{Value: "field"},
+		Src:      types.String{Value: "https://example.com/color-picker.html"},
+		Srcdoc:   types.String{Null: true},
+		Config:   JSONValue{Value: `{ "palette": ["#fff", "#000"], "alpha": true }`},
+		DataType: types.String{Value: "text"},
+		Multiple: types.Bool{Null: true},
+	}
+
+	input := NewExtensionInput(plan)
+	assert.JSONEq(t, plan.Config.Value, string(input.Config))
+	assert.Equal(t, []string{}, input.Tags)
+
+	// The configuration as written by the user is kept when Contentstack
+	// returns it formatted differently.
+	extension := &contentstack.Extension{
+		UID:      "blt1",
+		Title:    input.Title,
+		Type:     input.Type,
+		Src:      input.Src,
+		Config:   json.RawMessage(`{"alpha":true,"palette":["#fff","#000"]}`),
+		DataType: input.DataType,
+	}
+	state := NewExtensionData(extension, plan)
+	plan.UID = types.String{Value: "blt1"}
+	assert.Equal(t, plan, state)
+
+	// Keys which Contentstack adds to the fields of a schema are part of the
+	// configuration of an extension.
+	extension.Config = json.RawMessage(`{"alpha":true,"palette":["#fff","#000"],"multiple":false}`)
+	state = NewExtensionData(extension, plan)
+	assert.JSONEq(t, string(extension.Config), state.Config.Value)
+
+	// The empty configuration returned when none is set is ignored.
+	plan.Config = JSONValue{Null: true}
+	input = NewExtensionInput(plan)
+	assert.Equal(t, json.RawMessage("{}"), input.Config)
+
+	extension.Config = input.Config
+	state = NewExtensionData(extension, plan)
+	assert.True(t, state.Config.Null)
+}
@@ -19,7 +19,7 @@ type GlobalFieldData struct {
 	Title             types.String `tfsdk:"title"`
 	Description       types.String `tfsdk:"description"`
 	MaintainRevisions types.Bool   `tfsdk:"maintain_revisions"`
-	Schema            types.String `tfsdk:"schema"`
+	Schema            JSONValue    `tfsdk:"schema"`
 }
 
 // Global Field Resource schema
@@ -49,9 +49,9 @@ func (r resourceGlobalFieldType) GetSchema(_ context.Context) (tfsdk.Schema, dia
 				Optional: true,
 			},
 			"schema": {
-				Type:        types.StringType,
+				Type:        jsonType{},
 				Optional:    true,
-				Description: "The schema as JSON. Use jsonencode(jsonecode(<schema>)) to work around wrong changes.",
+				Description: "The schema as JSON. Differences in formatting, key order and default values added by Contentstack are ignored.",
 			},
 		},
 	}, nil
@@ -105,10 +105,10 @@ func (r resourceGlobalField) Read(ctx context.Context, req tfsdk.ReadResourceReq
 	resource, err := r.p.stack.GlobalFieldFetch(ctx, state.UID.Value)
 	if err != nil {
 		if IsNotFoundError(err) {
-			d := diag.NewErrorDiagnostic(
-				"Error retrieving global field",
-				fmt.Sprintf("The global field with UID %s was not found.", state.UID.Value))
-			resp.Diagnostics.Append(d)
+			resp.Diagnostics.AddWarning(
+				"Global field not found",
+				fmt.Sprintf("The global field with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
 		} else {
 			diags := processRemoteError(err)
 			resp.Diagnostics.Append(diags...)
@@ -122,6 +122,7 @@ func (r resourceGlobalField) Read(ctx context.Context, req tfsdk.ReadResourceReq
 
 	// Set state
 	newState := NewGlobalFieldData(resource)
+	newState.Schema = semanticJSONValue(state.Schema, newState.Schema)
 	diags = resp.State.Set(ctx, &newState)
 	resp.Diagnostics.Append(diags...)
 }
@@ -197,7 +198,7 @@ func NewGlobalFieldData(field *management.GlobalField) *GlobalFieldData {
 		Title:             types.String{Value: field.Title},
 		Description:       types.String{Value: field.Description},
 		MaintainRevisions: types.Bool{Value: field.MaintainRevisions},
-		Schema:            types.String{Value: string(schemaContent)},
+		Schema:            JSONValue{Value: string(schemaContent)},
 	}
 	return state
 }
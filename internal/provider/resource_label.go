@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+type resourceLabelType struct{}
+
+type LabelData struct {
+	UID          types.String   `tfsdk:"uid"`
+	Name         types.String   `tfsdk:"name"`
+	Parent       types.String   `tfsdk:"parent"`
+	ContentTypes []types.String `tfsdk:"content_types"`
+}
+
+// Label Resource schema
+func (r resourceLabelType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		Labels are used to organise content types in the Contentstack UI.
+		Labels can be nested by setting a parent label.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"parent": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The UID of the parent label.",
+			},
+			"content_types": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The UIDs of the content types with this label.",
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceLabelType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceLabel{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceLabel struct {
+	p provider
+}
+
+func (r resourceLabel) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan LabelData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := NewLabelInput(&plan)
+	label, err := r.p.stack.LabelCreate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state := NewLabelData(label, &plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceLabel) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state LabelData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	label, err := r.p.stack.LabelFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Label not found",
+				fmt.Sprintf("The label with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState := NewLabelData(label, &state)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceLabel) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state LabelData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete label by calling API
+	err := r.p.stack.LabelDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceLabel) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan LabelData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state LabelData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := NewLabelInput(&plan)
+	label, err := r.p.stack.LabelUpdate(ctx, state.UID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	result := NewLabelData(label, &plan)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceLabel) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+func NewLabelData(label *contentstack.Label, prior *LabelData) *LabelData {
+	state := &LabelData{
+		UID:    types.String{Value: label.UID},
+		Name:   types.String{Value: label.Name},
+		Parent: types.String{Null: true},
+	}
+
+	// Contentstack supports a list of parents, but the UI only allows a
+	// single parent.
+	if len(label.Parent) > 0 {
+		state.Parent = types.String{Value: label.Parent[0]}
+	}
+
+	if len(label.ContentTypes) > 0 || prior.ContentTypes != nil {
+		state.ContentTypes = newStringList(label.ContentTypes)
+	}
+	return state
+}
+
+func NewLabelInput(label *LabelData) *contentstack.LabelInput {
+	input := &contentstack.LabelInput{
+		Name:         label.Name.Value,
+		Parent:       []string{},
+		ContentTypes: []string{},
+	}
+	if !label.Parent.Null {
+		input.Parent = append(input.Parent, label.Parent.Value)
+	}
+	for _, uid := range label.ContentTypes {
+		input.ContentTypes = append(input.ContentTypes, uid.Value)
+	}
+	return input
+}
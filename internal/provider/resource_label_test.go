@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestLabelRoundTrip(t *testing.T) {
+	plan := &LabelData{
+		Name:   types.String{Value: "Pages"},
+		Parent: types.String{Value: "blt1"},
+	}
+
+	input := NewLabelInput(plan)
+	assert.Equal(t, []string{"blt1"}, input.Parent)
+	assert.Equal(t, []string{}, input.ContentTypes)
+
+	state := NewLabelData(&contentstack.Label{
+		UID:          "blt2",
+		Name:         input.Name,
+		Parent:       input.Parent,
+		ContentTypes: input.ContentTypes,
+	}, plan)
+	plan.UID = types.String{Value: "blt2"}
+	assert.Equal(t, plan, state)
+
+	// A parent removed and content types assigned in the UI are read back
+	// as they are, so both show up as a change.
+	state = NewLabelData(&contentstack.Label{
+		UID:          "blt2",
+		Name:         "Pages",
+		ContentTypes: []string{"page"},
+	}, plan)
+	assert.True(t, state.Parent.Null)
+	assert.Equal(t, []types.String{{Value: "page"}}, state.ContentTypes)
+}
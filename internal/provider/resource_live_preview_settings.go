@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+type resourceLivePreviewSettingsType struct{}
+
+type LivePreviewSettingsData struct {
+	Enabled            types.Bool                      `tfsdk:"enabled"`
+	DefaultEnvironment types.String                    `tfsdk:"default_environment"`
+	DefaultURL         types.String                    `tfsdk:"default_url"`
+	ContentTypeURLs    []LivePreviewContentTypeURLData `tfsdk:"content_type_url"`
+}
+
+type LivePreviewContentTypeURLData struct {
+	ContentType types.String `tfsdk:"content_type"`
+	URL         types.String `tfsdk:"url"`
+}
+
+// Live Preview Settings Resource schema
+func (r resourceLivePreviewSettingsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		The Live Preview settings of the stack. Live Preview shows the changes
+		to an entry in the website while editing, using the preview token of a
+		delivery token to fetch the unpublished content.
+
+		Note: There is only one set of settings per stack, so this resource
+		should be defined at most once. Live Preview is disabled when the
+		resource is destroyed.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"enabled": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Whether Live Preview is enabled. Defaults to true.",
+			},
+			"default_environment": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The UID of the environment of which the URLs are used for the preview.",
+			},
+			"default_url": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The URL of the website used for the preview of content types without a specific URL.",
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"content_type_url": {
+				NestingMode: tfsdk.BlockNestingModeList,
+				Description: "The URL of the website used for the preview of the entries of a content type.",
+				Attributes: map[string]tfsdk.Attribute{
+					"content_type": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The UID of the content type.",
+					},
+					"url": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The preview URL.",
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceLivePreviewSettingsType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceLivePreviewSettings{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceLivePreviewSettings struct {
+	p provider
+}
+
+func (r resourceLivePreviewSettings) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan LivePreviewSettingsData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := NewLivePreviewSettingsInput(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.p.stack.StackSettingsUpdate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state, diags := NewLivePreviewSettingsData(settings, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceLivePreviewSettings) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state LivePreviewSettingsData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.p.stack.StackSettingsFetch(ctx)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	newState, diags := NewLivePreviewSettingsData(settings, &state)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceLivePreviewSettings) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// Disable live preview, the settings themselves cannot be removed.
+	input, diags := NewLivePreviewSettingsInput(&LivePreviewSettingsData{
+		Enabled: types.Bool{Value: false},
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.p.stack.StackSettingsUpdate(ctx, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceLivePreviewSettings) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan LivePreviewSettingsData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := NewLivePreviewSettingsInput(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.p.stack.StackSettingsUpdate(ctx, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	result, diags := NewLivePreviewSettingsData(settings, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// livePreviewSettings are the Live Preview settings in the JSON
+// representation of the stack settings.
+type livePreviewSettings struct {
+	Enabled            bool                        `json:"enabled"`
+	DefaultEnvironment string                      `json:"default-env"`
+	DefaultURL         string                      `json:"default-url"`
+	ContentTypeURLs    []livePreviewContentTypeURL `json:"content_type_urls"`
+}
+
+type livePreviewContentTypeURL struct {
+	ContentType string `json:"content_type_uid"`
+	URL         string `json:"url"`
+}
+
+func NewLivePreviewSettingsData(settings *contentstack.StackSettings, prior *LivePreviewSettingsData) (*LivePreviewSettingsData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	livePreview := livePreviewSettings{}
+	if len(settings.LivePreview) > 0 {
+		if err := json.Unmarshal(settings.LivePreview, &livePreview); err != nil {
+			diags.AddError("Unable to parse live preview settings", err.Error())
+		}
+	}
+
+	state := &LivePreviewSettingsData{
+		Enabled:            types.Bool{Value: livePreview.Enabled},
+		DefaultEnvironment: types.String{Value: livePreview.DefaultEnvironment},
+		DefaultURL:         types.String{Value: livePreview.DefaultURL},
+		ContentTypeURLs:    []LivePreviewContentTypeURLData{},
+	}
+	if livePreview.Enabled && prior.Enabled.Null {
+		state.Enabled = prior.Enabled
+	}
+	if livePreview.DefaultURL == "" && prior.DefaultURL.Null {
+		state.DefaultURL = prior.DefaultURL
+	}
+
+	for _, u := range livePreview.ContentTypeURLs {
+		state.ContentTypeURLs = append(state.ContentTypeURLs, LivePreviewContentTypeURLData{
+			ContentType: types.String{Value: u.ContentType},
+			URL:         types.String{Value: u.URL},
+		})
+	}
+	return state, diags
+}
+
+func NewLivePreviewSettingsInput(settings *LivePreviewSettingsData) (*contentstack.StackSettingsInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	livePreview := livePreviewSettings{
+		Enabled:            settings.Enabled.Null || settings.Enabled.Value,
+		DefaultEnvironment: settings.DefaultEnvironment.Value,
+		DefaultURL:         settings.DefaultURL.Value,
+		ContentTypeURLs:    []livePreviewContentTypeURL{},
+	}
+	for _, u := range settings.ContentTypeURLs {
+		livePreview.ContentTypeURLs = append(livePreview.ContentTypeURLs, livePreviewContentTypeURL{
+			ContentType: u.ContentType.Value,
+			URL:         u.URL.Value,
+		})
+	}
+
+	data, err := json.Marshal(livePreview)
+	if err != nil {
+		diags.AddError("Unable to serialize live preview settings", err.Error())
+		return nil, diags
+	}
+
+	input := &contentstack.StackSettingsInput{
+		LivePreview: data,
+	}
+	return input, diags
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestLivePreviewSettingsRoundTrip(t *testing.T) {
+	plan := &LivePreviewSettingsData{
+		Enabled:            types.Bool{Null: true},
+		DefaultEnvironment: types.String{Value: "development"},
+		DefaultURL:         types.String{Null: true},
+		ContentTypeURLs: []LivePreviewContentTypeURLData{
+			{
+				ContentType: types.String{Value: "page"},
+				URL:         types.String{Value: "https://example.com"},
+			},
+		},
+	}
+
+	input, diags := NewLivePreviewSettingsInput(plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.JSONEq(t, `{
+		"enabled": true,
+		"default-env": "development",
+		"default-url": "",
+		"content_type_urls": [{"content_type_uid": "page", "url": "https://example.com"}]
+	}`, string(input.LivePreview))
+
+	// The settings are returned as they were sent.
+	state, diags := NewLivePreviewSettingsData(&contentstack.StackSettings{LivePreview: input.LivePreview}, plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, plan, state)
+}
+
+func TestLivePreviewSettingsDisabled(t *testing.T) {
+	input, diags := NewLivePreviewSettingsInput(&LivePreviewSettingsData{
+		Enabled: types.Bool{Value: false},
+	})
+	assert.False(t, diags.HasError(), diags)
+	assert.JSONEq(t, `{"enabled": false, "default-env": "", "default-url": "", "content_type_urls": []}`, string(input.LivePreview))
+
+	// Stacks without Live Preview settings return no settings at all.
+	prior := &LivePreviewSettingsData{
+		Enabled:            types.Bool{Value: true},
+		DefaultEnvironment: types.String{Value: "development"},
+		DefaultURL:         types.String{Null: true},
+	}
+	state, diags := NewLivePreviewSettingsData(&contentstack.StackSettings{}, prior)
+	assert.False(t, diags.HasError(), diags)
+	assert.False(t, state.Enabled.Value)
+	assert.Equal(t, "", state.DefaultEnvironment.Value)
+	assert.True(t, state.DefaultURL.Null)
+}
@@ -100,10 +100,10 @@ func (r resourceLocale) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 	resource, err := r.p.stack.LocaleFetch(ctx, state.Code.Value)
 	if err != nil {
 		if IsNotFoundError(err) {
-			d := diag.NewErrorDiagnostic(
-				"Error retrieving locale",
-				fmt.Sprintf("The locale %s was not found.", state.Code.Value))
-			resp.Diagnostics.Append(d)
+			resp.Diagnostics.AddWarning(
+				"Locale not found",
+				fmt.Sprintf("The locale %s was not found, removing it from the state.", state.Code.Value))
+			resp.State.RemoveResource(ctx)
 		} else {
 			diags := processRemoteError(err)
 			resp.Diagnostics.Append(diags...)
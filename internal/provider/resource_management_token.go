@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+const managementTokenDateFormat = "2006-01-02"
+
+type resourceManagementTokenType struct{}
+
+type ManagementTokenData struct {
+	UID               types.String   `tfsdk:"uid"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	ContentTypeACL    types.String   `tfsdk:"content_type_acl"`
+	Branches          []types.String `tfsdk:"branches"`
+	BranchAliases     []types.String `tfsdk:"branch_aliases"`
+	ExpiresOn         types.String   `tfsdk:"expires_on"`
+	RotateWhenChanged types.Map      `tfsdk:"rotate_when_changed"`
+	Token             types.String   `tfsdk:"token"`
+}
+
+// Management Token Resource schema
+func (r resourceManagementTokenType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		Management tokens are stack-level tokens with read or read-write access
+		to the content types, entries and assets of a stack, limited to the
+		given branches and aliases.
+
+		Note: The token is only returned by Contentstack when it is created,
+		so it is not available for imported tokens. Change any value of
+		rotate_when_changed to replace the token with a new one.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"content_type_acl": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The access to the content types, entries and assets, one of read or read_write.",
+				Validators: []tfsdk.AttributeValidator{
+					oneOfValidator{values: []string{"read", "read_write"}},
+				},
+			},
+			"branches": {
+				Type:        types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The UIDs of the branches the token gives access to.",
+			},
+			"branch_aliases": {
+				Type:        types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The UIDs of the branch aliases the token gives access to.",
+			},
+			"expires_on": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The date on which the token expires, formatted as YYYY-MM-DD. The token never expires when not set.",
+			},
+			"rotate_when_changed": {
+				Type:        types.MapType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "Arbitrary values which replace the token with a new one when changed.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"token": {
+				Type:        types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated management token.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceManagementTokenType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceManagementToken{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceManagementToken struct {
+	p provider
+}
+
+func (r resourceManagementToken) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config ManagementTokenData
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ExpiresOn.Null || config.ExpiresOn.Unknown {
+		return
+	}
+
+	if _, err := time.Parse(managementTokenDateFormat, config.ExpiresOn.Value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("expires_on"),
+			"Invalid date",
+			fmt.Sprintf("The value %q is not a valid date, the date should be formatted as YYYY-MM-DD.", config.ExpiresOn.Value),
+		)
+	}
+}
+
+func (r resourceManagementToken) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan ManagementTokenData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := NewManagementTokenInput(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.p.stack.ManagementTokenCreate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state, diags := NewManagementTokenData(token, &plan)
+	resp.Diagnostics.Append(diags...)
+	state.Token = types.String{Value: token.Token}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceManagementToken) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state ManagementTokenData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.p.stack.ManagementTokenFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Management token not found",
+				fmt.Sprintf("The management token with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState, diags := NewManagementTokenData(token, &state)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceManagementToken) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state ManagementTokenData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete token by calling API
+	err := r.p.stack.ManagementTokenDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceManagementToken) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan ManagementTokenData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state ManagementTokenData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := NewManagementTokenInput(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.p.stack.ManagementTokenUpdate(ctx, state.UID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	result, diags := NewManagementTokenData(token, &plan)
+	resp.Diagnostics.Append(diags...)
+	result.Token = state.Token
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceManagementToken) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+// NewManagementTokenData converts the token to its state. The token value is
+// only returned when the token is created, so it is always taken from the
+// prior state here, as are the values which are not stored by Contentstack.
+func NewManagementTokenData(token *contentstack.ManagementToken, prior *ManagementTokenData) (*ManagementTokenData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	state := &ManagementTokenData{
+		UID:               types.String{Value: token.UID},
+		Name:              types.String{Value: token.Name},
+		Description:       types.String{Value: token.Description},
+		ContentTypeACL:    types.String{Value: "read"},
+		ExpiresOn:         types.String{Null: true},
+		RotateWhenChanged: prior.RotateWhenChanged,
+		Token:             prior.Token,
+	}
+	if token.Description == "" && prior.Description.Null {
+		state.Description = prior.Description
+	}
+	if state.RotateWhenChanged.ElemType == nil {
+		state.RotateWhenChanged = types.Map{Null: true, ElemType: types.StringType}
+	}
+	if state.Token.Unknown {
+		state.Token = types.String{Null: true}
+	}
+
+	// Contentstack returns the expiry as timestamp, only the date is kept.
+	if token.ExpiresOn != "" {
+		expiresOn := token.ExpiresOn
+		if t, err := time.Parse(time.RFC3339, expiresOn); err == nil {
+			expiresOn = t.UTC().Format(managementTokenDateFormat)
+		}
+		state.ExpiresOn = types.String{Value: expiresOn}
+	}
+
+	scope := []tokenScope{}
+	if len(token.Scope) > 0 {
+		if err := json.Unmarshal(token.Scope, &scope); err != nil {
+			diags.AddError("Unable to parse scope", err.Error())
+			return state, diags
+		}
+	}
+
+	if s := findTokenScope(scope, "content_type"); s != nil && s.ACL["write"] {
+		state.ContentTypeACL = types.String{Value: "read_write"}
+	}
+	if s := findTokenScope(scope, "branch"); s != nil {
+		state.Branches = []types.String{}
+		for _, name := range s.Branches {
+			state.Branches = append(state.Branches, types.String{Value: name})
+		}
+	}
+	if s := findTokenScope(scope, "branch_alias"); s != nil {
+		state.BranchAliases = []types.String{}
+		for _, name := range s.BranchAliases {
+			state.BranchAliases = append(state.BranchAliases, types.String{Value: name})
+		}
+	}
+
+	// Stacks without branches enabled don't return the branch scope.
+	if state.Branches == nil {
+		state.Branches = prior.Branches
+	}
+	if state.BranchAliases == nil {
+		state.BranchAliases = prior.BranchAliases
+	}
+	return state, diags
+}
+
+func NewManagementTokenInput(token *ManagementTokenData) (*contentstack.ManagementTokenInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scope := []tokenScope{
+		{
+			Module: "content_type",
+			ACL: map[string]bool{
+				"read":  true,
+				"write": token.ContentTypeACL.Value == "read_write",
+			},
+		},
+	}
+
+	if token.Branches != nil {
+		branches := tokenScopeNames{}
+		for _, name := range token.Branches {
+			branches = append(branches, name.Value)
+		}
+		scope = append(scope, tokenScope{
+			Module:   "branch",
+			Branches: branches,
+			ACL:      map[string]bool{"read": true},
+		})
+	}
+
+	if token.BranchAliases != nil {
+		aliases := tokenScopeNames{}
+		for _, name := range token.BranchAliases {
+			aliases = append(aliases, name.Value)
+		}
+		scope = append(scope, tokenScope{
+			Module:        "branch_alias",
+			BranchAliases: aliases,
+			ACL:           map[string]bool{"read": true},
+		})
+	}
+
+	data, err := json.Marshal(scope)
+	if err != nil {
+		diags.AddError("Unable to serialize scope", err.Error())
+		return nil, diags
+	}
+
+	input := &contentstack.ManagementTokenInput{
+		Name:        token.Name.Value,
+		Description: token.Description.Value,
+		Scope:       data,
+	}
+	if !token.ExpiresOn.Null {
+		input.ExpiresOn = &token.ExpiresOn.Value
+	}
+	return input, diags
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestManagementTokenRoundTrip(t *testing.T) {
+	plan := &ManagementTokenData{
+		Name:              types.String{Value: "Deploy"},
+		Description:       types.String{Null: true},
+		ContentTypeACL:    types.String{Value: "read_write"},
+		BranchAliases:     []types.String{{Value: "deploy"}},
+		ExpiresOn:         types.String{Value: "2027-01-31"},
+		RotateWhenChanged: types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{"version": types.String{Value: "1"}}},
+		Token:             types.String{Unknown: true},
+	}
+
+	input, diags := NewManagementTokenInput(plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "2027-01-31", *input.ExpiresOn)
+	assert.JSONEq(t, `[
+		{"module": "content_type", "acl": {"read": true, "write": true}},
+		{"module": "branch_alias", "branch_aliases": ["deploy"], "acl": {"read": true}}
+	]`, string(input.Scope))
+
+	state, diags := NewManagementTokenData(&contentstack.ManagementToken{
+		UID:       "token",
+		Name:      "Deploy",
+		ExpiresOn: "2027-01-31T00:00:00.000Z",
+		Scope:     input.Scope,
+	}, plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, plan.ContentTypeACL, state.ContentTypeACL)
+	assert.Equal(t, plan.BranchAliases, state.BranchAliases)
+	assert.Nil(t, state.Branches)
+	assert.Equal(t, plan.ExpiresOn, state.ExpiresOn)
+	assert.Equal(t, plan.RotateWhenChanged, state.RotateWhenChanged)
+	assert.True(t, state.Token.Null)
+}
+
+func TestManagementTokenRemoveExpiry(t *testing.T) {
+	plan := &ManagementTokenData{
+		Name:           types.String{Value: "Deploy"},
+		Description:    types.String{Null: true},
+		ContentTypeACL: types.String{Value: "read"},
+		ExpiresOn:      types.String{Null: true},
+	}
+
+	// The expiry is sent as null, so it is removed when the token is updated.
+	input, diags := NewManagementTokenInput(plan)
+	assert.False(t, diags.HasError(), diags)
+	data, err := json.Marshal(input)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"expires_on":null`)
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+type resourcePreviewTokenType struct{}
+
+type PreviewTokenData struct {
+	DeliveryTokenUID types.String `tfsdk:"delivery_token_uid"`
+	Token            types.String `tfsdk:"token"`
+}
+
+// Preview Token Resource schema
+func (r resourcePreviewTokenType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		A preview token gives access to unpublished content for Live Preview.
+		The preview token belongs to a delivery token and has access to the
+		same environments and branches.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"delivery_token_uid": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The UID of the delivery token the preview token belongs to.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"token": {
+				Type:        types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated preview token.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourcePreviewTokenType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourcePreviewToken{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourcePreviewToken struct {
+	p provider
+}
+
+func (r resourcePreviewToken) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan PreviewTokenData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.p.stack.PreviewTokenCreate(ctx, plan.DeliveryTokenUID.Value)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state := NewPreviewTokenData(token)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePreviewToken) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state PreviewTokenData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The preview token is part of the delivery token.
+	token, err := r.p.stack.DeliveryTokenFetch(ctx, state.DeliveryTokenUID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Delivery token not found",
+				fmt.Sprintf("The delivery token with UID %s was not found, removing the preview token from the state.", state.DeliveryTokenUID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	if token.PreviewToken == "" {
+		resp.Diagnostics.AddWarning(
+			"Preview token not found",
+			fmt.Sprintf("The delivery token with UID %s has no preview token, removing it from the state.", state.DeliveryTokenUID.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set state
+	newState := NewPreviewTokenData(token)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePreviewToken) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state PreviewTokenData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete token by calling API
+	err := r.p.stack.PreviewTokenDelete(ctx, state.DeliveryTokenUID.Value)
+	if err != nil && !IsNotFoundError(err) {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+// Update is never called since changing the delivery token requires the
+// preview token to be replaced.
+func (r resourcePreviewToken) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError(
+		"Preview token cannot be updated",
+		"Any change requires the preview token to be replaced.",
+	)
+}
+
+func (r resourcePreviewToken) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("delivery_token_uid"), req, resp)
+}
+
+func NewPreviewTokenData(token *contentstack.DeliveryToken) *PreviewTokenData {
+	state := &PreviewTokenData{
+		DeliveryTokenUID: types.String{Value: token.UID},
+		Token:            types.String{Value: token.PreviewToken},
+	}
+	return state
+}
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestPreviewTokenData(t *testing.T) {
+	state := NewPreviewTokenData(&contentstack.DeliveryToken{
+		UID:          "token",
+		Name:         "Website",
+		Token:        "cs123",
+		PreviewToken: "csp456",
+	})
+	assert.Equal(t, "token", state.DeliveryTokenUID.Value)
+	assert.Equal(t, "csp456", state.Token.Value)
+}
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+// publishRuleActions lists the actions a publish rule can apply to.
+var publishRuleActions = []string{"publish", "unpublish"}
+
+type resourcePublishRuleType struct{}
+
+type PublishRuleData struct {
+	UID                       types.String   `tfsdk:"uid"`
+	Workflow                  types.String   `tfsdk:"workflow"`
+	WorkflowStage             types.String   `tfsdk:"workflow_stage"`
+	Environment               types.String   `tfsdk:"environment"`
+	ContentTypes              []types.String `tfsdk:"content_types"`
+	Locales                   []types.String `tfsdk:"locales"`
+	Actions                   []types.String `tfsdk:"actions"`
+	ApproverUsers             []types.String `tfsdk:"approver_users"`
+	ApproverRoles             []types.String `tfsdk:"approver_roles"`
+	DisableApproverPublishing types.Bool     `tfsdk:"disable_approver_publishing"`
+}
+
+// Publish Rule Resource schema
+func (r resourcePublishRuleType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		A publish rule requires the approval of the given users or roles before
+		entries of the content types can be published to or unpublished from
+		the environment in the given locales.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"workflow": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The UID of the workflow the rule belongs to.",
+			},
+			"workflow_stage": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The name of the stage of the workflow entries need to be in before they can be published.",
+			},
+			"environment": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The UID of the environment the rule applies to.",
+			},
+			"content_types": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Required:    true,
+				Description: "The UIDs of the content types the rule applies to. Use `$all` for all content types.",
+			},
+			"locales": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Required:    true,
+				Description: "The codes of the locales the rule applies to.",
+			},
+			"actions": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Required: true,
+				Description: fmt.Sprintf(
+					"The actions which require approval, any of %s.",
+					strings.Join(publishRuleActions, ", ")),
+			},
+			"approver_users": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The UIDs of the users who can approve the actions.",
+			},
+			"approver_roles": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The UIDs of the roles of which the users can approve the actions.",
+			},
+			"disable_approver_publishing": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Whether the approvers are prevented from publishing the entries themselves. Defaults to false.",
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourcePublishRuleType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourcePublishRule{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourcePublishRule struct {
+	p provider
+}
+
+func (r resourcePublishRule) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config PublishRuleData
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, action := range config.Actions {
+		if action.Unknown || action.Null || stringInSlice(action.Value, publishRuleActions) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("actions"),
+			"Invalid value",
+			fmt.Sprintf(
+				"The action %q is not valid, the value must be one of: %s.",
+				action.Value, strings.Join(publishRuleActions, ", ")),
+		)
+	}
+}
+
+func (r resourcePublishRule) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan PublishRuleData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stageUIDs, diags := r.workflowStageUIDs(ctx, plan.Workflow.Value)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := NewPublishRuleInput(&plan, stageUIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.p.stack.PublishRuleCreate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state, diags := NewPublishRuleData(rule, &plan, stageUIDs)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePublishRule) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state PublishRuleData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.p.stack.PublishRuleFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Publish rule not found",
+				fmt.Sprintf("The publish rule with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	stageUIDs, diags := r.workflowStageUIDs(ctx, rule.Workflow)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	newState, diags := NewPublishRuleData(rule, &state, stageUIDs)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePublishRule) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state PublishRuleData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete publish rule by calling API
+	err := r.p.stack.PublishRuleDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourcePublishRule) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan PublishRuleData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state PublishRuleData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stageUIDs, diags := r.workflowStageUIDs(ctx, plan.Workflow.Value)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := NewPublishRuleInput(&plan, stageUIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.p.stack.PublishRuleUpdate(ctx, state.UID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	result, diags := NewPublishRuleData(rule, &plan, stageUIDs)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePublishRule) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+// workflowStageUIDs fetches the workflow to resolve the names of the stages,
+// since the publish rule refers to the stage by its UID.
+func (r resourcePublishRule) workflowStageUIDs(ctx context.Context, uid string) (map[string]string, diag.Diagnostics) {
+	workflow, err := r.p.stack.WorkflowFetch(ctx, uid)
+	if err != nil {
+		return nil, processRemoteError(err)
+	}
+	return workflowStageUIDs(workflow)
+}
+
+// publishRuleApprovers are the approvers in the JSON representation of a
+// publish rule.
+type publishRuleApprovers struct {
+	Users []string `json:"users"`
+	Roles []string `json:"roles"`
+}
+
+func NewPublishRuleData(rule *contentstack.PublishRule, prior *PublishRuleData, stageUIDs map[string]string) (*PublishRuleData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	state := &PublishRuleData{
+		UID:                       types.String{Value: rule.UID},
+		Workflow:                  types.String{Value: rule.Workflow},
+		WorkflowStage:             types.String{Null: true},
+		Environment:               types.String{Value: rule.Environment},
+		ContentTypes:              newStringList(rule.ContentTypes),
+		Locales:                   newStringList(rule.Locales),
+		Actions:                   newStringList(rule.Actions),
+		DisableApproverPublishing: optionalBoolValue(rule.DisableApproverPublishing, prior.DisableApproverPublishing),
+	}
+
+	if rule.WorkflowStage != "" {
+		state.WorkflowStage = types.String{Value: rule.WorkflowStage}
+		for name, uid := range stageUIDs {
+			if uid == rule.WorkflowStage {
+				state.WorkflowStage = types.String{Value: name}
+			}
+		}
+	}
+
+	approvers := publishRuleApprovers{}
+	if len(rule.Approvers) > 0 {
+		if err := json.Unmarshal(rule.Approvers, &approvers); err != nil {
+			diags.AddError("Unable to parse approvers", err.Error())
+		}
+	}
+	if len(approvers.Users) > 0 || prior.ApproverUsers != nil {
+		state.ApproverUsers = newStringList(approvers.Users)
+	}
+	if len(approvers.Roles) > 0 || prior.ApproverRoles != nil {
+		state.ApproverRoles = newStringList(approvers.Roles)
+	}
+	return state, diags
+}
+
+func NewPublishRuleInput(rule *PublishRuleData, stageUIDs map[string]string) (*contentstack.PublishRuleInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	input := &contentstack.PublishRuleInput{
+		Workflow:                  rule.Workflow.Value,
+		Environment:               rule.Environment.Value,
+		ContentTypes:              []string{},
+		Locales:                   []string{},
+		Actions:                   []string{},
+		DisableApproverPublishing: rule.DisableApproverPublishing.Value,
+	}
+
+	if !rule.WorkflowStage.Null {
+		uid, ok := stageUIDs[rule.WorkflowStage.Value]
+		if !ok {
+			diags.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("workflow_stage"),
+				"Unknown workflow stage",
+				fmt.Sprintf("The workflow %s has no stage %s.", rule.Workflow.Value, rule.WorkflowStage.Value),
+			)
+			return nil, diags
+		}
+		input.WorkflowStage = uid
+	}
+
+	for _, uid := range rule.ContentTypes {
+		input.ContentTypes = append(input.ContentTypes, uid.Value)
+	}
+	for _, code := range rule.Locales {
+		input.Locales = append(input.Locales, code.Value)
+	}
+	for _, action := range rule.Actions {
+		input.Actions = append(input.Actions, action.Value)
+	}
+
+	approvers := publishRuleApprovers{
+		Users: []string{},
+		Roles: []string{},
+	}
+	for _, uid := range rule.ApproverUsers {
+		approvers.Users = append(approvers.Users, uid.Value)
+	}
+	for _, uid := range rule.ApproverRoles {
+		approvers.Roles = append(approvers.Roles, uid.Value)
+	}
+
+	data, err := json.Marshal(approvers)
+	if err != nil {
+		diags.AddError("Unable to serialize approvers", err.Error())
+		return nil, diags
+	}
+	input.Approvers = data
+	return input, diags
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestPublishRuleRoundTrip(t *testing.T) {
+	plan := &PublishRuleData{
+		Workflow:                  types.String{Value: "blt0"},
+		WorkflowStage:             types.String{Value: "Approved"},
+		Environment:               types.String{Value: "blt9"},
+		ContentTypes:              []types.String{{Value: "page"}},
+		Locales:                   []types.String{{Value: "en-us"}, {Value: "nl-nl"}},
+		Actions:                   []types.String{{Value: "publish"}},
+		ApproverRoles:             []types.String{{Value: "blt5"}},
+		DisableApproverPublishing: types.Bool{Null: true},
+	}
+	stageUIDs := map[string]string{"Review": "blt1", "Approved": "blt2"}
+
+	input, diags := NewPublishRuleInput(plan, stageUIDs)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "blt2", input.WorkflowStage)
+	assert.JSONEq(t, `{"users": [], "roles": ["blt5"]}`, string(input.Approvers))
+
+	rule := &contentstack.PublishRule{
+		UID:           "blt3",
+		Workflow:      input.Workflow,
+		WorkflowStage: input.WorkflowStage,
+		Environment:   input.Environment,
+		ContentTypes:  input.ContentTypes,
+		Locales:       input.Locales,
+		Actions:       input.Actions,
+		Approvers:     input.Approvers,
+	}
+	state, diags := NewPublishRuleData(rule, plan, stageUIDs)
+	assert.False(t, diags.HasError(), diags)
+
+	plan.UID = types.String{Value: "blt3"}
+	assert.Equal(t, plan, state)
+
+	// Unknown stages are reported.
+	plan.WorkflowStage = types.String{Value: "Published"}
+	_, diags = NewPublishRuleInput(plan, stageUIDs)
+	assert.True(t, diags.HasError())
+}
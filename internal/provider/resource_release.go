@@ -0,0 +1,573 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+// Releases are deployed asynchronously by Contentstack. The status of the
+// deployment is polled every releasePollInterval until all items are
+// deployed.
+const (
+	releasePollInterval = 5 * time.Second
+	releaseTimeout      = 15 * time.Minute
+)
+
+var releaseItemActions = []string{"publish", "unpublish"}
+
+type resourceReleaseType struct{}
+
+type ReleaseData struct {
+	UID         types.String        `tfsdk:"uid"`
+	Name        types.String        `tfsdk:"name"`
+	Description types.String        `tfsdk:"description"`
+	Locked      types.Bool          `tfsdk:"locked"`
+	Items       []ReleaseItemData   `tfsdk:"item"`
+	Deploy      []ReleaseDeployData `tfsdk:"deploy"`
+}
+
+type ReleaseItemData struct {
+	UID         types.String `tfsdk:"uid"`
+	Version     types.Int64  `tfsdk:"version"`
+	ContentType types.String `tfsdk:"content_type"`
+	Locale      types.String `tfsdk:"locale"`
+	Action      types.String `tfsdk:"action"`
+}
+
+type ReleaseDeployData struct {
+	Environments []types.String `tfsdk:"environments"`
+	Locales      []types.String `tfsdk:"locales"`
+}
+
+// Release Resource schema
+func (r resourceReleaseType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		A release bundles entries and assets, so these can be published or
+		unpublished together. The release is deployed when a deploy block is
+		given, and deployed again when the items or the deploy block change.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"locked": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Whether the release is locked for changes in the Contentstack UI. Defaults to false.",
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"item": {
+				NestingMode: tfsdk.BlockNestingModeList,
+				Description: "The entries and assets in the release.",
+				Attributes: map[string]tfsdk.Attribute{
+					"uid": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The UID of the entry or asset.",
+					},
+					"version": {
+						Type:        types.Int64Type,
+						Required:    true,
+						Description: "The version of the entry or asset.",
+					},
+					"content_type": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The UID of the content type of the entry, or `built_io_upload` for assets.",
+					},
+					"locale": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The code of the locale of the entry.",
+					},
+					"action": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The action to perform on deploy, one of " + strings.Join(releaseItemActions, ", ") + ".",
+						Validators: []tfsdk.AttributeValidator{
+							oneOfValidator{values: releaseItemActions},
+						},
+					},
+				},
+			},
+			"deploy": {
+				NestingMode: tfsdk.BlockNestingModeList,
+				MaxItems:    1,
+				Description: "Deploys the release and waits until the deployment is finished.",
+				Attributes: map[string]tfsdk.Attribute{
+					"environments": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Required:    true,
+						Description: "The names of the environments to deploy the release to.",
+					},
+					"locales": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Required:    true,
+						Description: "The codes of the locales to deploy the release in.",
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceReleaseType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceRelease{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceRelease struct {
+	p provider
+}
+
+func (r resourceRelease) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan ReleaseData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The release is locked after the items are added.
+	input := NewReleaseInput(&plan)
+	input.Locked = false
+	release, err := r.p.stack.ReleaseCreate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write the empty release to the state, so it isn't lost when one of the
+	// next steps fails.
+	state, diags := NewReleaseData(release, &ReleaseData{Description: plan.Description, Locked: types.Bool{Null: true}})
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(ctx, state, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceRelease) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state ReleaseData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	release, err := r.p.stack.ReleaseFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Release not found",
+				fmt.Sprintf("The release with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState, diags := NewReleaseData(release, &state)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceRelease) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state ReleaseData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete release by calling API. This doesn't unpublish the deployed
+	// items.
+	err := r.p.stack.ReleaseDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceRelease) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan ReleaseData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state ReleaseData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(ctx, &state, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	// Set state
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceRelease) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+// apply updates the release from the state to the plan. The items can only be
+// changed when the release is unlocked, so the release is unlocked first when
+// needed. The release is deployed when the items or the deploy block changed.
+// The resulting state contains the steps which succeeded, so it is always set
+// even when an error is returned.
+func (r resourceRelease) apply(ctx context.Context, state, plan *ReleaseData) (*ReleaseData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	uid := state.UID.Value
+	result := *state
+
+	added, removed := releaseItemChanges(state.Items, plan.Items)
+	itemsChanged := len(added) > 0 || len(removed) > 0
+
+	if itemsChanged && state.Locked.Value {
+		input := NewReleaseInput(plan)
+		input.Locked = false
+		if _, err := r.p.stack.ReleaseUpdate(ctx, uid, *input); err != nil {
+			return &result, processRemoteError(err)
+		}
+		result.Locked = types.Bool{Value: false}
+	}
+
+	if len(removed) > 0 {
+		items, d := newReleaseItems(removed)
+		diags.Append(d...)
+		if diags.HasError() {
+			return &result, diags
+		}
+		if err := r.p.stack.ReleaseItemsDelete(ctx, uid, items); err != nil {
+			diags.Append(processRemoteError(err)...)
+			return &result, diags
+		}
+		result.Items = []ReleaseItemData{}
+		for _, item := range state.Items {
+			if findReleaseItem(removed, item) == nil {
+				result.Items = append(result.Items, item)
+			}
+		}
+	}
+	if len(added) > 0 {
+		items, d := newReleaseItems(added)
+		diags.Append(d...)
+		if diags.HasError() {
+			return &result, diags
+		}
+		if err := r.p.stack.ReleaseItemsAdd(ctx, uid, items); err != nil {
+			diags.Append(processRemoteError(err)...)
+			return &result, diags
+		}
+		result.Items = append(result.Items, added...)
+	}
+
+	release, err := r.p.stack.ReleaseUpdate(ctx, uid, *NewReleaseInput(plan))
+	if err != nil {
+		diags.Append(processRemoteError(err)...)
+		return &result, diags
+	}
+
+	deployChanged := len(plan.Deploy) > 0 && !releaseDeployEqual(state.Deploy, plan.Deploy)
+	if len(plan.Deploy) > 0 && (itemsChanged || deployChanged) {
+		// The deploy block is left out of the state until the deployment
+		// succeeded, so a failed deployment is retried.
+		updated, d := NewReleaseData(release, plan)
+		diags.Append(d...)
+		result = *updated
+		result.Deploy = []ReleaseDeployData{}
+
+		// The status of the previous deployment is kept until the new
+		// deployment starts.
+		previous := release
+		input := NewReleaseDeployInput(&plan.Deploy[0])
+		if err := r.p.stack.ReleaseDeploy(ctx, uid, *input); err != nil {
+			diags.Append(processRemoteError(err)...)
+			return &result, diags
+		}
+
+		err = waitFor(ctx, releasePollInterval, releaseTimeout, func() (bool, error) {
+			release, err = r.p.stack.ReleaseFetch(ctx, uid)
+			if err != nil {
+				return false, err
+			}
+			return releaseDeployed(release, previous, input.Environments)
+		})
+		if err != nil {
+			diags.AddError(
+				"Release not deployed",
+				fmt.Sprintf("The release with UID %s could not be deployed: %s", uid, err.Error()),
+			)
+			return &result, diags
+		}
+	}
+
+	updated, d := NewReleaseData(release, plan)
+	diags.Append(d...)
+	return updated, diags
+}
+
+// releaseItem is an item in the JSON representation of a release.
+type releaseItem struct {
+	UID         string `json:"uid"`
+	Version     int64  `json:"version"`
+	ContentType string `json:"content_type_uid"`
+	Locale      string `json:"locale"`
+	Action      string `json:"action"`
+}
+
+// releaseDeployment is the status of the deployment of a release to an
+// environment. The time identifies the deployment.
+type releaseDeployment struct {
+	Environment string `json:"environment"`
+	Status      string `json:"status"`
+	Time        string `json:"time"`
+}
+
+// releaseDeployments returns the status of the deployments of the release per
+// environment.
+func releaseDeployments(release *contentstack.Release) (map[string]releaseDeployment, error) {
+	deployments := []releaseDeployment{}
+	if len(release.Status) > 0 {
+		if err := json.Unmarshal(release.Status, &deployments); err != nil {
+			return nil, err
+		}
+	}
+
+	result := map[string]releaseDeployment{}
+	for _, deployment := range deployments {
+		result[deployment.Environment] = deployment
+	}
+	return result, nil
+}
+
+// releaseDeployed reports whether the deployment of the release to all given
+// environments is finished. The status of an environment is only taken into
+// account when it differs from the status of the previous deployment, and only
+// a successful status finishes the deployment. An error is returned when the
+// deployment failed.
+func releaseDeployed(release, previous *contentstack.Release, environments []string) (bool, error) {
+	current, err := releaseDeployments(release)
+	if err != nil {
+		return false, err
+	}
+	prior, err := releaseDeployments(previous)
+	if err != nil {
+		return false, err
+	}
+
+	pending := 0
+	for _, environment := range environments {
+		deployment, ok := current[environment]
+		if !ok || deployment == prior[environment] {
+			pending++
+			continue
+		}
+
+		switch deployment.Status {
+		case "success":
+		case "failed":
+			return false, fmt.Errorf("deployment to %s failed", environment)
+		default:
+			pending++
+		}
+	}
+	return pending == 0, nil
+}
+
+// releaseItemChanges returns the items which are added to and removed from
+// the release. Items with a changed version or action are replaced.
+func releaseItemChanges(current, planned []ReleaseItemData) (added, removed []ReleaseItemData) {
+	for _, item := range planned {
+		if findReleaseItem(current, item) == nil {
+			added = append(added, item)
+		}
+	}
+	for _, item := range current {
+		if findReleaseItem(planned, item) == nil {
+			removed = append(removed, item)
+		}
+	}
+	return added, removed
+}
+
+func findReleaseItem(items []ReleaseItemData, item ReleaseItemData) *ReleaseItemData {
+	for i := range items {
+		if items[i].UID.Equal(item.UID) &&
+			items[i].Version.Equal(item.Version) &&
+			items[i].ContentType.Equal(item.ContentType) &&
+			items[i].Locale.Equal(item.Locale) &&
+			items[i].Action.Equal(item.Action) {
+			return &items[i]
+		}
+	}
+	return nil
+}
+
+func releaseDeployEqual(a, b []ReleaseDeployData) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !stringValuesEqual(a[i].Environments, b[i].Environments) || !stringValuesEqual(a[i].Locales, b[i].Locales) {
+			return false
+		}
+	}
+	return true
+}
+
+// stringValuesEqual reports whether both sets contain the same values.
+func stringValuesEqual(a, b []types.String) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, value := range a {
+		if !containsStringValue(b, value.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func newReleaseItems(items []ReleaseItemData) (json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := []releaseItem{}
+	for _, item := range items {
+		result = append(result, releaseItem{
+			UID:         item.UID.Value,
+			Version:     item.Version.Value,
+			ContentType: item.ContentType.Value,
+			Locale:      item.Locale.Value,
+			Action:      item.Action.Value,
+		})
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		diags.AddError("Unable to serialize release items", err.Error())
+		return nil, diags
+	}
+	return data, diags
+}
+
+func NewReleaseData(release *contentstack.Release, prior *ReleaseData) (*ReleaseData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	state := &ReleaseData{
+		UID:         types.String{Value: release.UID},
+		Name:        types.String{Value: release.Name},
+		Description: types.String{Value: release.Description},
+		Locked:      optionalBoolValue(release.Locked, prior.Locked),
+		Items:       []ReleaseItemData{},
+		Deploy:      prior.Deploy,
+	}
+	if release.Description == "" && prior.Description.Null {
+		state.Description = prior.Description
+	}
+
+	items := []releaseItem{}
+	if len(release.Items) > 0 {
+		if err := json.Unmarshal(release.Items, &items); err != nil {
+			diags.AddError("Unable to parse release items", err.Error())
+			return state, diags
+		}
+	}
+
+	remote := []ReleaseItemData{}
+	for _, item := range items {
+		remote = append(remote, ReleaseItemData{
+			UID:         types.String{Value: item.UID},
+			Version:     types.Int64{Value: item.Version},
+			ContentType: types.String{Value: item.ContentType},
+			Locale:      types.String{Value: item.Locale},
+			Action:      types.String{Value: item.Action},
+		})
+	}
+
+	// Keep the order of the prior items, Contentstack returns the items in
+	// the order in which they were added.
+	for _, item := range prior.Items {
+		if findReleaseItem(remote, item) != nil {
+			state.Items = append(state.Items, item)
+		}
+	}
+	for _, item := range remote {
+		if findReleaseItem(prior.Items, item) == nil {
+			state.Items = append(state.Items, item)
+		}
+	}
+	return state, diags
+}
+
+func NewReleaseInput(release *ReleaseData) *contentstack.ReleaseInput {
+	input := &contentstack.ReleaseInput{
+		Name:        release.Name.Value,
+		Description: release.Description.Value,
+		Locked:      release.Locked.Value,
+	}
+	return input
+}
+
+func NewReleaseDeployInput(deploy *ReleaseDeployData) *contentstack.ReleaseDeployInput {
+	input := &contentstack.ReleaseDeployInput{
+		Environments: []string{},
+		Locales:      []string{},
+	}
+	for _, name := range deploy.Environments {
+		input.Environments = append(input.Environments, name.Value)
+	}
+	for _, code := range deploy.Locales {
+		input.Locales = append(input.Locales, code.Value)
+	}
+	return input
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func newTestReleaseItem(uid string, version int64) ReleaseItemData {
+	return ReleaseItemData{
+		UID:         types.String{Value: uid},
+		Version:     types.Int64{Value: version},
+		ContentType: types.String{Value: "page"},
+		Locale:      types.String{Value: "en-us"},
+		Action:      types.String{Value: "publish"},
+	}
+}
+
+func TestReleaseItemChanges(t *testing.T) {
+	current := []ReleaseItemData{
+		newTestReleaseItem("blt1", 1),
+		newTestReleaseItem("blt2", 1),
+	}
+	planned := []ReleaseItemData{
+		newTestReleaseItem("blt1", 2),
+		newTestReleaseItem("blt2", 1),
+		newTestReleaseItem("blt3", 1),
+	}
+
+	added, removed := releaseItemChanges(current, planned)
+	assert.Equal(t, []ReleaseItemData{planned[0], planned[2]}, added)
+	assert.Equal(t, []ReleaseItemData{current[0]}, removed)
+
+	added, removed = releaseItemChanges(planned, planned)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+}
+
+func TestNewReleaseData(t *testing.T) {
+	prior := &ReleaseData{
+		Name:        types.String{Value: "Launch"},
+		Description: types.String{Null: true},
+		Locked:      types.Bool{Null: true},
+		Items: []ReleaseItemData{
+			newTestReleaseItem("blt2", 1),
+			newTestReleaseItem("blt1", 1),
+		},
+	}
+
+	// The order of the prior items is kept, items added outside of
+	// Terraform are appended.
+	items, _ := json.Marshal([]releaseItem{
+		{UID: "blt1", Version: 1, ContentType: "page", Locale: "en-us", Action: "publish"},
+		{UID: "blt2", Version: 1, ContentType: "page", Locale: "en-us", Action: "publish"},
+		{UID: "blt4", Version: 3, ContentType: "page", Locale: "en-us", Action: "publish"},
+	})
+	release := &contentstack.Release{
+		UID:   "blt0",
+		Name:  "Launch",
+		Items: items,
+	}
+	state, diags := NewReleaseData(release, prior)
+	assert.False(t, diags.HasError(), diags)
+	assert.True(t, state.Description.Null)
+	assert.True(t, state.Locked.Null)
+	assert.Equal(t, []ReleaseItemData{
+		newTestReleaseItem("blt2", 1),
+		newTestReleaseItem("blt1", 1),
+		newTestReleaseItem("blt4", 3),
+	}, state.Items)
+}
+
+func TestReleaseDeployed(t *testing.T) {
+	environments := []string{"staging", "production"}
+	previous := &contentstack.Release{}
+
+	// The deployment hasn't started yet.
+	release := &contentstack.Release{}
+	done, err := releaseDeployed(release, previous, environments)
+	assert.NoError(t, err)
+	assert.False(t, done)
+
+	release.Status = json.RawMessage(`[{"environment": "staging", "status": "success", "time": "2026-01-01T10:00:00Z"}]`)
+	done, err = releaseDeployed(release, previous, environments)
+	assert.NoError(t, err)
+	assert.False(t, done)
+
+	release.Status = json.RawMessage(`[
+		{"environment": "staging", "status": "success", "time": "2026-01-01T10:00:00Z"},
+		{"environment": "production", "status": "in_progress", "time": "2026-01-01T10:00:00Z"}
+	]`)
+	done, err = releaseDeployed(release, previous, environments)
+	assert.NoError(t, err)
+	assert.False(t, done)
+
+	// Unknown statuses don't finish the deployment.
+	release.Status = json.RawMessage(`[
+		{"environment": "staging", "status": "success", "time": "2026-01-01T10:00:00Z"},
+		{"environment": "production", "status": "scheduled", "time": "2026-01-01T10:00:00Z"}
+	]`)
+	done, err = releaseDeployed(release, previous, environments)
+	assert.NoError(t, err)
+	assert.False(t, done)
+
+	release.Status = json.RawMessage(`[
+		{"environment": "staging", "status": "success", "time": "2026-01-01T10:00:00Z"},
+		{"environment": "production", "status": "success", "time": "2026-01-01T10:00:00Z"}
+	]`)
+	done, err = releaseDeployed(release, previous, environments)
+	assert.NoError(t, err)
+	assert.True(t, done)
+
+	// The status of the previous deployment is ignored when the release is
+	// deployed again.
+	previous = &contentstack.Release{Status: release.Status}
+	done, err = releaseDeployed(release, previous, environments)
+	assert.NoError(t, err)
+	assert.False(t, done)
+
+	release.Status = json.RawMessage(`[
+		{"environment": "staging", "status": "success", "time": "2026-01-02T10:00:00Z"},
+		{"environment": "production", "status": "success", "time": "2026-01-02T10:00:00Z"}
+	]`)
+	done, err = releaseDeployed(release, previous, environments)
+	assert.NoError(t, err)
+	assert.True(t, done)
+
+	release.Status = json.RawMessage(`[
+		{"environment": "staging", "status": "in_progress", "time": "2026-01-03T10:00:00Z"},
+		{"environment": "production", "status": "failed", "time": "2026-01-03T10:00:00Z"}
+	]`)
+	_, err = releaseDeployed(release, previous, environments)
+	assert.EqualError(t, err, "deployment to production failed")
+}
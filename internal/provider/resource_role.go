@@ -0,0 +1,530 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+// roleModules lists the modules which can be used in the rules of a role,
+// with the key holding the targets of the rule in the JSON representation.
+var roleModules = map[string]string{
+	"content_type": "content_types",
+	"asset":        "assets",
+	"folder":       "folders",
+	"environment":  "environments",
+	"locale":       "locales",
+	"branch":       "branches",
+}
+
+// roleSubACLModules lists the modules supporting permissions on the items
+// within the target, i.e. the entries of a content type or the assets of a
+// folder.
+var roleSubACLModules = []string{"content_type", "folder"}
+
+type resourceRoleType struct{}
+
+type RoleData struct {
+	UID           types.String   `tfsdk:"uid"`
+	Name          types.String   `tfsdk:"name"`
+	Description   types.String   `tfsdk:"description"`
+	DeployContent types.Bool     `tfsdk:"deploy_content"`
+	Rules         []RoleRuleData `tfsdk:"rule"`
+}
+
+type RoleRuleData struct {
+	Module  types.String   `tfsdk:"module"`
+	Targets []types.String `tfsdk:"targets"`
+	ACL     RoleACLData    `tfsdk:"acl"`
+	SubACL  *RoleACLData   `tfsdk:"sub_acl"`
+}
+
+type RoleACLData struct {
+	Read    types.Bool `tfsdk:"read"`
+	Create  types.Bool `tfsdk:"create"`
+	Update  types.Bool `tfsdk:"update"`
+	Delete  types.Bool `tfsdk:"delete"`
+	Publish types.Bool `tfsdk:"publish"`
+}
+
+func roleACLAttributes() map[string]tfsdk.Attribute {
+	attributes := map[string]tfsdk.Attribute{}
+	for _, name := range []string{"read", "create", "update", "delete", "publish"} {
+		attributes[name] = tfsdk.Attribute{
+			Type:        types.BoolType,
+			Optional:    true,
+			Description: fmt.Sprintf("Whether the role is allowed to %s. Defaults to false.", name),
+		}
+	}
+	return attributes
+}
+
+// Role Resource schema
+func (r resourceRoleType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	modules := make([]string, 0, len(roleModules))
+	for module := range roleModules {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	return tfsdk.Schema{
+		Description: `
+		A role is a collection of permissions that is applied to the users of
+		a stack. The permissions are defined with rules, each granting access
+		to a module of the stack.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"deploy_content": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Whether users with this role can deploy content to releases. Defaults to false.",
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"rule": {
+				NestingMode: tfsdk.BlockNestingModeSet,
+				Description: "The permissions of the role. The order of the rules is not significant.",
+				Attributes: map[string]tfsdk.Attribute{
+					"module": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The module the rule applies to, one of " + strings.Join(modules, ", ") + ".",
+						Validators: []tfsdk.AttributeValidator{
+							oneOfValidator{values: modules},
+						},
+					},
+					"targets": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Required:    true,
+						Description: "The UIDs of the items in the module the rule applies to. Use `$all` for all items.",
+					},
+					"acl": {
+						Required:    true,
+						Description: "The permissions on the targets.",
+						Attributes:  tfsdk.SingleNestedAttributes(roleACLAttributes()),
+					},
+					"sub_acl": {
+						Optional:    true,
+						Description: "The permissions on the entries of the content types or the assets in the folders. Only for the `content_type` and `folder` modules.",
+						Attributes:  tfsdk.SingleNestedAttributes(roleACLAttributes()),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceRoleType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceRole{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceRole struct {
+	p provider
+}
+
+func (r resourceRole) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config RoleData
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, rule := range config.Rules {
+		if rule.SubACL == nil || rule.Module.Unknown || stringInSlice(rule.Module.Value, roleSubACLModules) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("rule"),
+			"Unsupported attribute",
+			fmt.Sprintf(
+				"The sub_acl attribute is only supported for rules of module %s, not for %s.",
+				strings.Join(roleSubACLModules, ", "), rule.Module.Value),
+		)
+	}
+}
+
+func (r resourceRole) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan RoleData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := NewRoleInput(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.p.stack.RoleCreate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state, diags := NewRoleData(role, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceRole) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state RoleData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.p.stack.RoleFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Role not found",
+				fmt.Sprintf("The role with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState, diags := NewRoleData(role, &state)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceRole) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state RoleData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete role by calling API
+	err := r.p.stack.RoleDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceRole) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan RoleData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state RoleData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := NewRoleInput(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The rules for modules which are not supported are not managed, but
+	// an update replaces all rules of the role. They are sent with their
+	// current values.
+	current, err := r.p.stack.RoleFetch(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	diags = keepUnmanagedRoleRules(input, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.p.stack.RoleUpdate(ctx, state.UID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	result, diags := NewRoleData(role, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceRole) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+// roleRule is a rule in the JSON representation of a role. The targets of the
+// rule are stored in the key belonging to the module, see roleModules.
+type roleRule struct {
+	Module  string
+	Targets []string
+	ACL     roleACL
+}
+
+type roleACL struct {
+	Read    bool     `json:"read"`
+	Create  bool     `json:"create"`
+	Update  bool     `json:"update"`
+	Delete  bool     `json:"delete"`
+	Publish bool     `json:"publish"`
+	SubACL  *roleACL `json:"sub_acl,omitempty"`
+}
+
+func (r roleRule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"module":              r.Module,
+		roleModules[r.Module]: r.Targets,
+		"acl":                 r.ACL,
+	})
+}
+
+func (r *roleRule) UnmarshalJSON(data []byte) error {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw["module"], &r.Module); err != nil {
+		return err
+	}
+	if targets, ok := raw[roleModules[r.Module]]; ok {
+		if err := json.Unmarshal(targets, &r.Targets); err != nil {
+			return err
+		}
+	}
+	if acl, ok := raw["acl"]; ok {
+		return json.Unmarshal(acl, &r.ACL)
+	}
+	return nil
+}
+
+func NewRoleData(role *contentstack.Role, prior *RoleData) (*RoleData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	state := &RoleData{
+		UID:           types.String{Value: role.UID},
+		Name:          types.String{Value: role.Name},
+		Description:   types.String{Value: role.Description},
+		DeployContent: optionalBoolValue(role.DeployContent, prior.DeployContent),
+		Rules:         []RoleRuleData{},
+	}
+	if role.Description == "" && prior.Description.Null {
+		state.Description = prior.Description
+	}
+
+	rules := []roleRule{}
+	if len(role.Rules) > 0 {
+		if err := json.Unmarshal(role.Rules, &rules); err != nil {
+			diags.AddError("Unable to parse rules", err.Error())
+			return state, diags
+		}
+	}
+
+	for _, rule := range rules {
+		// Contentstack adds rules for modules which are not supported by
+		// this resource, these are not managed.
+		if _, ok := roleModules[rule.Module]; !ok {
+			continue
+		}
+
+		priorRule := findRoleRule(prior.Rules, rule.Module, rule.Targets)
+		data := RoleRuleData{
+			Module:  types.String{Value: rule.Module},
+			Targets: []types.String{},
+			ACL:     newRoleACLData(rule.ACL, priorRule.ACL),
+		}
+		for _, target := range rule.Targets {
+			data.Targets = append(data.Targets, types.String{Value: target})
+		}
+		if rule.ACL.SubACL != nil && stringInSlice(rule.Module, roleSubACLModules) {
+			priorSubACL := RoleACLData{}
+			if priorRule.SubACL != nil {
+				priorSubACL = *priorRule.SubACL
+			}
+			subACL := newRoleACLData(*rule.ACL.SubACL, priorSubACL)
+			if priorRule.SubACL != nil || *rule.ACL.SubACL != (roleACL{}) {
+				data.SubACL = &subACL
+			}
+		}
+		state.Rules = append(state.Rules, data)
+	}
+	return state, diags
+}
+
+func newRoleACLData(acl roleACL, prior RoleACLData) RoleACLData {
+	return RoleACLData{
+		Read:    optionalBoolValue(acl.Read, prior.Read),
+		Create:  optionalBoolValue(acl.Create, prior.Create),
+		Update:  optionalBoolValue(acl.Update, prior.Update),
+		Delete:  optionalBoolValue(acl.Delete, prior.Delete),
+		Publish: optionalBoolValue(acl.Publish, prior.Publish),
+	}
+}
+
+// findRoleRule returns the rule for the given module and targets, or an
+// empty rule when there is none.
+func findRoleRule(rules []RoleRuleData, module string, targets []string) RoleRuleData {
+	expected := append([]string{}, targets...)
+	sort.Strings(expected)
+
+	for _, rule := range rules {
+		if rule.Module.Value != module || len(rule.Targets) != len(expected) {
+			continue
+		}
+
+		actual := make([]string, 0, len(rule.Targets))
+		for _, target := range rule.Targets {
+			actual = append(actual, target.Value)
+		}
+		sort.Strings(actual)
+		if strings.Join(actual, ",") == strings.Join(expected, ",") {
+			return rule
+		}
+	}
+	return RoleRuleData{}
+}
+
+// optionalBoolValue returns the value, or null when the value is false and
+// wasn't set explicitly before.
+func optionalBoolValue(value bool, prior types.Bool) types.Bool {
+	if value || (!prior.Null && !prior.Unknown) {
+		return types.Bool{Value: value}
+	}
+	return types.Bool{Null: true}
+}
+
+func NewRoleInput(role *RoleData) (*contentstack.RoleInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rules := []roleRule{}
+	for _, r := range role.Rules {
+		rule := roleRule{
+			Module:  r.Module.Value,
+			Targets: []string{},
+			ACL:     newRoleACL(r.ACL),
+		}
+		for _, target := range r.Targets {
+			rule.Targets = append(rule.Targets, target.Value)
+		}
+		if r.SubACL != nil {
+			subACL := newRoleACL(*r.SubACL)
+			rule.ACL.SubACL = &subACL
+		}
+		rules = append(rules, rule)
+	}
+
+	data, err := json.Marshal(rules)
+	if err != nil {
+		diags.AddError("Unable to serialize rules", err.Error())
+		return nil, diags
+	}
+
+	input := &contentstack.RoleInput{
+		Name:          role.Name.Value,
+		Description:   role.Description.Value,
+		DeployContent: role.DeployContent.Value,
+		Rules:         data,
+	}
+	return input, diags
+}
+
+// keepUnmanagedRoleRules adds the rules of the current role for modules which
+// are not supported by this resource to the input, so they are left untouched
+// by the update.
+func keepUnmanagedRoleRules(input *contentstack.RoleInput, current *contentstack.Role) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(current.Rules) == 0 {
+		return diags
+	}
+
+	rules := []json.RawMessage{}
+	if err := json.Unmarshal(input.Rules, &rules); err != nil {
+		diags.AddError("Unable to parse rules", err.Error())
+		return diags
+	}
+
+	currentRules := []json.RawMessage{}
+	if err := json.Unmarshal(current.Rules, &currentRules); err != nil {
+		diags.AddError("Unable to parse rules", err.Error())
+		return diags
+	}
+
+	for _, raw := range currentRules {
+		rule := struct {
+			Module string `json:"module"`
+		}{}
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			diags.AddError("Unable to parse rules", err.Error())
+			return diags
+		}
+		if _, ok := roleModules[rule.Module]; !ok {
+			rules = append(rules, raw)
+		}
+	}
+
+	data, err := json.Marshal(rules)
+	if err != nil {
+		diags.AddError("Unable to serialize rules", err.Error())
+		return diags
+	}
+	input.Rules = data
+	return diags
+}
+
+func newRoleACL(acl RoleACLData) roleACL {
+	return roleACL{
+		Read:    acl.Read.Value,
+		Create:  acl.Create.Value,
+		Update:  acl.Update.Value,
+		Delete:  acl.Delete.Value,
+		Publish: acl.Publish.Value,
+	}
+}
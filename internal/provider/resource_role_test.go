@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestRoleRulesRoundTrip(t *testing.T) {
+	plan := &RoleData{
+		Name:          types.String{Value: "Editor"},
+		Description:   types.String{Null: true},
+		DeployContent: types.Bool{Null: true},
+		Rules: []RoleRuleData{
+			{
+				Module:  types.String{Value: "content_type"},
+				Targets: []types.String{{Value: "page"}, {Value: "article"}},
+				ACL: RoleACLData{
+					Read:    types.Bool{Value: true},
+					Create:  types.Bool{Null: true},
+					Update:  types.Bool{Null: true},
+					Delete:  types.Bool{Value: false},
+					Publish: types.Bool{Null: true},
+				},
+				SubACL: &RoleACLData{
+					Read:    types.Bool{Value: true},
+					Create:  types.Bool{Value: true},
+					Update:  types.Bool{Value: true},
+					Delete:  types.Bool{Null: true},
+					Publish: types.Bool{Null: true},
+				},
+			},
+			{
+				Module:  types.String{Value: "environment"},
+				Targets: []types.String{{Value: "$all"}},
+				ACL: RoleACLData{
+					Read:    types.Bool{Value: true},
+					Create:  types.Bool{Null: true},
+					Update:  types.Bool{Null: true},
+					Delete:  types.Bool{Null: true},
+					Publish: types.Bool{Null: true},
+				},
+			},
+		},
+	}
+
+	input, diags := NewRoleInput(plan)
+	assert.False(t, diags.HasError(), diags)
+
+	var raw []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(input.Rules, &raw))
+	assert.Equal(t, []interface{}{"page", "article"}, raw[0]["content_types"])
+	assert.Equal(t, []interface{}{"$all"}, raw[1]["environments"])
+
+	// Contentstack returns the rules in a different order, with targets in a
+	// different order and with additional rules for unsupported modules.
+	rules := `[
+		{"module": "taxonomy", "taxonomies": ["$all"], "acl": {"read": true}},
+		{"module": "environment", "environments": ["$all"], "acl": {"read": true}},
+		{"module": "content_type", "content_types": ["article", "page"], "acl": {
+			"read": true,
+			"sub_acl": {"read": true, "create": true, "update": true}
+		}}
+	]`
+	state, diags := NewRoleData(&contentstack.Role{
+		UID:   "role",
+		Name:  "Editor",
+		Rules: json.RawMessage(rules),
+	}, plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.Len(t, state.Rules, 2)
+	assert.Equal(t, plan.Rules[1], state.Rules[0])
+	assert.Equal(t, plan.Rules[0].ACL, state.Rules[1].ACL)
+	assert.Equal(t, plan.Rules[0].SubACL, state.Rules[1].SubACL)
+	assert.True(t, state.DeployContent.Null)
+	assert.True(t, state.Description.Null)
+}
+
+func TestRoleUnmanagedRules(t *testing.T) {
+	input := &contentstack.RoleInput{
+		Name:  "Editor",
+		Rules: json.RawMessage(`[{"module": "environment", "environments": ["$all"], "acl": {"read": true}}]`),
+	}
+
+	// Only the rules for unsupported modules are kept, the others are
+	// replaced by the configured rules.
+	diags := keepUnmanagedRoleRules(input, &contentstack.Role{
+		UID:  "role",
+		Name: "Editor",
+		Rules: json.RawMessage(`[
+			{"module": "taxonomy", "taxonomies": ["$all"], "acl": {"read": true}},
+			{"module": "environment", "environments": ["production"], "acl": {"read": true}}
+		]`),
+	})
+	assert.False(t, diags.HasError(), diags)
+	assert.JSONEq(t, `[
+		{"module": "environment", "environments": ["$all"], "acl": {"read": true}},
+		{"module": "taxonomy", "taxonomies": ["$all"], "acl": {"read": true}}
+	]`, string(input.Rules))
+}
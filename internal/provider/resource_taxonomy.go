@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+type resourceTaxonomyType struct{}
+
+type TaxonomyData struct {
+	UID         types.String `tfsdk:"uid"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// Taxonomy Resource schema
+func (r resourceTaxonomyType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		A taxonomy is a hierarchy of terms used to categorise entries. The
+		terms of a taxonomy are managed with the contentstack_term resource,
+		and can be selected in taxonomy fields of content types.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The UID of the taxonomy. Changing the UID creates a new taxonomy.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceTaxonomyType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceTaxonomy{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceTaxonomy struct {
+	p provider
+}
+
+func (r resourceTaxonomy) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan TaxonomyData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := NewTaxonomyInput(&plan)
+	taxonomy, err := r.p.stack.TaxonomyCreate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state := NewTaxonomyData(taxonomy, &plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceTaxonomy) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state TaxonomyData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taxonomy, err := r.p.stack.TaxonomyFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Taxonomy not found",
+				fmt.Sprintf("The taxonomy with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState := NewTaxonomyData(taxonomy, &state)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceTaxonomy) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state TaxonomyData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete taxonomy by calling API
+	err := r.p.stack.TaxonomyDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceTaxonomy) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan TaxonomyData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state TaxonomyData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := NewTaxonomyInput(&plan)
+	taxonomy, err := r.p.stack.TaxonomyUpdate(ctx, state.UID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state
+	result := NewTaxonomyData(taxonomy, &plan)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceTaxonomy) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+func NewTaxonomyData(taxonomy *contentstack.Taxonomy, prior *TaxonomyData) *TaxonomyData {
+	state := &TaxonomyData{
+		UID:         types.String{Value: taxonomy.UID},
+		Name:        types.String{Value: taxonomy.Name},
+		Description: types.String{Value: taxonomy.Description},
+	}
+	if taxonomy.Description == "" && prior.Description.Null {
+		state.Description = prior.Description
+	}
+	return state
+}
+
+func NewTaxonomyInput(taxonomy *TaxonomyData) *contentstack.TaxonomyInput {
+	input := &contentstack.TaxonomyInput{
+		UID:         taxonomy.UID.Value,
+		Name:        taxonomy.Name.Value,
+		Description: taxonomy.Description.Value,
+	}
+	return input
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+type resourceTermType struct{}
+
+type TermData struct {
+	TaxonomyUID types.String `tfsdk:"taxonomy_uid"`
+	UID         types.String `tfsdk:"uid"`
+	Name        types.String `tfsdk:"name"`
+	ParentUID   types.String `tfsdk:"parent_uid"`
+	Order       types.Int64  `tfsdk:"order"`
+}
+
+// Term Resource schema
+func (r resourceTermType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		A term is a category within a taxonomy. Terms are nested by setting a
+		parent term, changing the parent or the order moves the term within
+		the hierarchy.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"taxonomy_uid": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The UID of the taxonomy of the term.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"uid": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The UID of the term. Changing the UID creates a new term.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"parent_uid": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The UID of the parent term. The term is created at the root of the taxonomy when not set.",
+			},
+			"order": {
+				Type:        types.Int64Type,
+				Optional:    true,
+				Description: "The position of the term among the terms with the same parent, starting at 1. The term is added after the existing terms when not set.",
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceTermType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceTerm{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceTerm struct {
+	p provider
+}
+
+func (r resourceTerm) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan TermData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := NewTermInput(&plan)
+	term, err := r.p.stack.TermCreate(ctx, plan.TaxonomyUID.Value, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write to state.
+	state := NewTermData(plan.TaxonomyUID.Value, term, &plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceTerm) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state TermData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	term, err := r.p.stack.TermFetch(ctx, state.TaxonomyUID.Value, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Term not found",
+				fmt.Sprintf("The term with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState := NewTermData(state.TaxonomyUID.Value, term, &state)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceTerm) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state TermData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete term by calling API, nothing to delete when the term was
+	// already removed together with its taxonomy or parent term.
+	err := r.p.stack.TermDelete(ctx, state.TaxonomyUID.Value, state.UID.Value)
+	if err != nil && !IsNotFoundError(err) {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceTerm) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan TermData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state TermData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := NewTermInput(&plan)
+	term, err := r.p.stack.TermUpdate(ctx, state.TaxonomyUID.Value, state.UID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// The position of a term can only be changed by moving it.
+	if termNeedsMove(&state, &plan) {
+		term, err = r.p.stack.TermMove(ctx, state.TaxonomyUID.Value, state.UID.Value, *NewTermMoveInput(&plan))
+		if err != nil {
+			diags = processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	// Set state
+	result := NewTermData(plan.TaxonomyUID.Value, term, &plan)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports a term with an ID in the format <taxonomy_uid>/<uid>.
+func (r resourceTerm) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("The import ID should be in the format <taxonomy_uid>/<uid>, got %q.", req.ID),
+		)
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("taxonomy_uid"), parts[0])
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), parts[1])
+	resp.Diagnostics.Append(diags...)
+}
+
+// termNeedsMove reports whether the parent or the order of the term changed.
+// Without an order the term stays where it is when the parent is unchanged.
+func termNeedsMove(state, plan *TermData) bool {
+	if !plan.ParentUID.Equal(state.ParentUID) {
+		return true
+	}
+	return !plan.Order.Null && !plan.Order.Equal(state.Order)
+}
+
+func NewTermData(taxonomyUID string, term *contentstack.Term, prior *TermData) *TermData {
+	state := &TermData{
+		TaxonomyUID: types.String{Value: taxonomyUID},
+		UID:         types.String{Value: term.UID},
+		Name:        types.String{Value: term.Name},
+		ParentUID:   types.String{Null: true},
+		Order:       types.Int64{Value: term.Order},
+	}
+	if term.ParentUID != "" {
+		state.ParentUID = types.String{Value: term.ParentUID}
+	}
+	if prior.Order.Null {
+		state.Order = prior.Order
+	}
+	return state
+}
+
+func NewTermInput(term *TermData) *contentstack.TermInput {
+	input := &contentstack.TermInput{
+		UID:       term.UID.Value,
+		Name:      term.Name.Value,
+		ParentUID: term.ParentUID.Value,
+		Order:     term.Order.Value,
+	}
+	return input
+}
+
+func NewTermMoveInput(term *TermData) *contentstack.TermMoveInput {
+	input := &contentstack.TermMoveInput{
+		ParentUID: term.ParentUID.Value,
+		Order:     term.Order.Value,
+	}
+	return input
+}
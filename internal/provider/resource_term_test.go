@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestNewTermData(t *testing.T) {
+	term := &contentstack.Term{
+		UID:       "shoes",
+		Name:      "Shoes",
+		ParentUID: "clothing",
+		Order:     2,
+	}
+
+	// The order is only kept when it was set explicitly.
+	state := NewTermData("product_categories", term, &TermData{Order: types.Int64{Null: true}})
+	assert.Equal(t, "product_categories", state.TaxonomyUID.Value)
+	assert.Equal(t, "clothing", state.ParentUID.Value)
+	assert.True(t, state.Order.Null)
+
+	state = NewTermData("product_categories", term, &TermData{Order: types.Int64{Value: 1}})
+	assert.Equal(t, int64(2), state.Order.Value)
+
+	term.ParentUID = ""
+	state = NewTermData("product_categories", term, &TermData{Order: types.Int64{Null: true}})
+	assert.True(t, state.ParentUID.Null)
+}
+
+func TestTermNeedsMove(t *testing.T) {
+	state := &TermData{
+		Name:      types.String{Value: "Shoes"},
+		ParentUID: types.String{Value: "clothing"},
+		Order:     types.Int64{Value: 2},
+	}
+
+	plan := *state
+	plan.Name = types.String{Value: "Footwear"}
+	assert.False(t, termNeedsMove(state, &plan))
+
+	plan.Order = types.Int64{Null: true}
+	assert.False(t, termNeedsMove(state, &plan))
+
+	plan.Order = types.Int64{Value: 1}
+	assert.True(t, termNeedsMove(state, &plan))
+
+	plan = *state
+	plan.ParentUID = types.String{Null: true}
+	assert.True(t, termNeedsMove(state, &plan))
+}
@@ -181,10 +181,10 @@ func (r resourceWebhook) Read(ctx context.Context, req tfsdk.ReadResourceRequest
 	webhook, err := r.p.stack.WebHookFetch(ctx, state.UID.Value)
 	if err != nil {
 		if IsNotFoundError(err) {
-			d := diag.NewErrorDiagnostic(
-				"Error retrieving webhook",
-				fmt.Sprintf("The webhook with UID %s was not found.", state.UID.Value))
-			resp.Diagnostics.Append(d)
+			resp.Diagnostics.AddWarning(
+				"Webhook not found",
+				fmt.Sprintf("The webhook with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
 		} else {
 			diags := processRemoteError(err)
 			resp.Diagnostics.Append(diags...)
@@ -0,0 +1,590 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+// workflowAll is used in the targets of a workflow to refer to all items.
+const workflowAll = "$all"
+
+type resourceWorkflowType struct{}
+
+type WorkflowData struct {
+	UID          types.String        `tfsdk:"uid"`
+	Name         types.String        `tfsdk:"name"`
+	Description  types.String        `tfsdk:"description"`
+	Enabled      types.Bool          `tfsdk:"enabled"`
+	ContentTypes []types.String      `tfsdk:"content_types"`
+	Branches     []types.String      `tfsdk:"branches"`
+	Stages       []WorkflowStageData `tfsdk:"stage"`
+}
+
+type WorkflowStageData struct {
+	Name                types.String   `tfsdk:"name"`
+	Color               types.String   `tfsdk:"color"`
+	SLA                 types.Int64    `tfsdk:"sla"`
+	AllowedUsers        []types.String `tfsdk:"allowed_users"`
+	AllowedRoles        []types.String `tfsdk:"allowed_roles"`
+	NextAvailableStages []types.String `tfsdk:"next_available_stages"`
+}
+
+// Workflow Resource schema
+func (r resourceWorkflowType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+		A workflow defines the review process of entries. Entries move through
+		the stages of the workflow in the order in which they are defined,
+		limited to the transitions and users allowed by each stage.
+		`,
+		Attributes: map[string]tfsdk.Attribute{
+			"uid": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"enabled": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Whether the workflow is enabled. Defaults to true.",
+			},
+			"content_types": {
+				Type:        types.ListType{ElemType: types.StringType},
+				Required:    true,
+				Description: "The UIDs of the content types the workflow applies to. Use `$all` for all content types.",
+			},
+			"branches": {
+				Type:        types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The UIDs of the branches the workflow applies to.",
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"stage": {
+				NestingMode: tfsdk.BlockNestingModeList,
+				MinItems:    1,
+				Description: "The stages of the workflow, in order.",
+				Attributes: map[string]tfsdk.Attribute{
+					"name": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The name of the stage, unique within the workflow.",
+					},
+					"color": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The color of the stage as hex code, e.g. `#2196f3`.",
+					},
+					"sla": {
+						Type:        types.Int64Type,
+						Optional:    true,
+						Description: "The number of days an entry is expected to stay in this stage.",
+					},
+					"allowed_users": {
+						Type:        types.ListType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "The UIDs of the users allowed to move entries out of this stage. Use `$all` for all users. Defaults to all users when neither users nor roles are set.",
+					},
+					"allowed_roles": {
+						Type:        types.ListType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "The UIDs of the roles allowed to move entries out of this stage.",
+					},
+					"next_available_stages": {
+						Type:        types.ListType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "The names of the stages entries can be moved to from this stage. Use `$all` for all stages. Defaults to all stages.",
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// New resource instance
+func (r resourceWorkflowType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceWorkflow{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceWorkflow struct {
+	p provider
+}
+
+func (r resourceWorkflow) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config WorkflowData
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = validateWorkflowStages(config.Stages)
+	resp.Diagnostics.Append(diags...)
+}
+
+// validateWorkflowStages checks that the stage names are unique and that the
+// transitions refer to existing stages.
+func validateWorkflowStages(stages []WorkflowStageData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names := map[string]bool{}
+	for i, stage := range stages {
+		if stage.Name.Unknown {
+			// The references can only be checked when all names are known.
+			return diags
+		}
+		if names[stage.Name.Value] {
+			diags.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("stage").WithElementKeyInt(i).WithAttributeName("name"),
+				"Duplicate stage",
+				fmt.Sprintf("The stage name %s is used more than once.", stage.Name.Value),
+			)
+		}
+		names[stage.Name.Value] = true
+	}
+
+	for i, stage := range stages {
+		path := tftypes.NewAttributePath().WithAttributeName("stage").WithElementKeyInt(i).WithAttributeName("next_available_stages")
+		for j, next := range stage.NextAvailableStages {
+			switch {
+			case next.Unknown || next.Null:
+				continue
+			case next.Value == workflowAll:
+				if len(stage.NextAvailableStages) > 1 {
+					diags.AddAttributeError(
+						path.WithElementKeyInt(j),
+						"Invalid stage",
+						fmt.Sprintf("The value %s cannot be combined with other stages.", workflowAll),
+					)
+				}
+			case next.Value == stage.Name.Value:
+				diags.AddAttributeError(
+					path.WithElementKeyInt(j),
+					"Invalid stage",
+					fmt.Sprintf("The stage %s cannot move entries to itself.", stage.Name.Value),
+				)
+			case !names[next.Value]:
+				diags.AddAttributeError(
+					path.WithElementKeyInt(j),
+					"Unknown stage",
+					fmt.Sprintf("The stage %s is not defined in this workflow.", next.Value),
+				)
+			}
+		}
+	}
+	return diags
+}
+
+func (r resourceWorkflow) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan WorkflowData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The transitions between stages refer to the UIDs of the stages, which
+	// are only known after the workflow is created.
+	input, complete, diags := NewWorkflowInput(&plan, map[string]string{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.p.stack.WorkflowCreate(ctx, *input)
+	if err != nil {
+		diags := processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Write the workflow to the state before the transitions are set, so it
+	// isn't lost when setting the transitions fails.
+	state, diags := NewWorkflowData(workflow, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if complete || resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, diags = r.updateTransitions(ctx, workflow, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags = NewWorkflowData(workflow, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceWorkflow) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state WorkflowData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.p.stack.WorkflowFetch(ctx, state.UID.Value)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Workflow not found",
+				fmt.Sprintf("The workflow with UID %s was not found, removing it from the state.", state.UID.Value))
+			resp.State.RemoveResource(ctx)
+		} else {
+			diags := processRemoteError(err)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	// Set state
+	newState, diags := NewWorkflowData(workflow, &state)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceWorkflow) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state WorkflowData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete workflow by calling API
+	err := r.p.stack.WorkflowDelete(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceWorkflow) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Get plan values
+	var plan WorkflowData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state WorkflowData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch the current stages, so existing stages are updated instead of
+	// replaced.
+	current, err := r.p.stack.WorkflowFetch(ctx, state.UID.Value)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	stageUIDs, diags := workflowStageUIDs(current)
+	resp.Diagnostics.Append(diags...)
+	input, complete, diags := NewWorkflowInput(&plan, stageUIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.p.stack.WorkflowUpdate(ctx, state.UID.Value, *input)
+	if err != nil {
+		diags = processRemoteError(err)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if !complete {
+		workflow, diags = r.updateTransitions(ctx, workflow, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set state
+	result, diags := NewWorkflowData(workflow, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// updateTransitions updates the workflow again once the UIDs of all stages
+// are known, so the transitions to new stages can be set.
+func (r resourceWorkflow) updateTransitions(ctx context.Context, workflow *contentstack.Workflow, plan *WorkflowData) (*contentstack.Workflow, diag.Diagnostics) {
+	stageUIDs, diags := workflowStageUIDs(workflow)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	input, _, d := NewWorkflowInput(plan, stageUIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result, err := r.p.stack.WorkflowUpdate(ctx, workflow.UID, *input)
+	if err != nil {
+		diags.Append(processRemoteError(err)...)
+		return nil, diags
+	}
+	return result, diags
+}
+
+func (r resourceWorkflow) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+// workflowStage is a stage in the JSON representation of a workflow.
+type workflowStage struct {
+	UID                 string           `json:"uid,omitempty"`
+	Name                string           `json:"name"`
+	Color               string           `json:"color,omitempty"`
+	SLA                 *int64           `json:"sla,omitempty"`
+	SysACL              workflowStageACL `json:"SYS_ACL"`
+	NextAvailableStages []string         `json:"next_available_stages"`
+	AllStages           bool             `json:"allStages"`
+	SpecificStages      bool             `json:"specificStages"`
+	AllUsers            bool             `json:"allUsers"`
+	SpecificUsers       bool             `json:"specificUsers"`
+}
+
+type workflowStageACL struct {
+	Roles  workflowUIDs `json:"roles"`
+	Users  workflowUIDs `json:"users"`
+	Others struct{}     `json:"others"`
+}
+
+type workflowUIDs struct {
+	UIDs []string `json:"uids"`
+}
+
+func parseWorkflowStages(workflow *contentstack.Workflow) ([]workflowStage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	stages := []workflowStage{}
+	if len(workflow.WorkflowStages) > 0 {
+		if err := json.Unmarshal(workflow.WorkflowStages, &stages); err != nil {
+			diags.AddError("Unable to parse workflow stages", err.Error())
+		}
+	}
+	return stages, diags
+}
+
+// workflowStageUIDs returns the UIDs of the stages of the workflow by their
+// name.
+func workflowStageUIDs(workflow *contentstack.Workflow) (map[string]string, diag.Diagnostics) {
+	stages, diags := parseWorkflowStages(workflow)
+
+	result := map[string]string{}
+	for _, stage := range stages {
+		result[stage.Name] = stage.UID
+	}
+	return result, diags
+}
+
+func NewWorkflowData(workflow *contentstack.Workflow, prior *WorkflowData) (*WorkflowData, diag.Diagnostics) {
+	state := &WorkflowData{
+		UID:          types.String{Value: workflow.UID},
+		Name:         types.String{Value: workflow.Name},
+		Description:  types.String{Value: workflow.Description},
+		Enabled:      types.Bool{Value: workflow.Enabled},
+		ContentTypes: []types.String{},
+		Stages:       []WorkflowStageData{},
+	}
+	if workflow.Description == "" && prior.Description.Null {
+		state.Description = prior.Description
+	}
+	if workflow.Enabled && prior.Enabled.Null {
+		state.Enabled = prior.Enabled
+	}
+
+	for _, uid := range workflow.ContentTypes {
+		state.ContentTypes = append(state.ContentTypes, types.String{Value: uid})
+	}
+	// The main branch is added by Contentstack when no branches are given,
+	// and branches are not returned at all when they are not part of the
+	// plan of the organization.
+	isDefault := len(workflow.Branches) == 1 && workflow.Branches[0] == "main"
+	if len(workflow.Branches) == 0 || (isDefault && prior.Branches == nil) {
+		state.Branches = prior.Branches
+	} else {
+		state.Branches = newStringList(workflow.Branches)
+	}
+
+	stages, diags := parseWorkflowStages(workflow)
+	names := map[string]string{}
+	for _, stage := range stages {
+		names[stage.UID] = stage.Name
+	}
+
+	for _, stage := range stages {
+		priorStage := WorkflowStageData{}
+		for _, s := range prior.Stages {
+			if s.Name.Value == stage.Name {
+				priorStage = s
+			}
+		}
+
+		data := WorkflowStageData{
+			Name:                types.String{Value: stage.Name},
+			Color:               types.String{Value: stage.Color},
+			SLA:                 types.Int64{Null: true},
+			AllowedUsers:        newStringList(stage.SysACL.Users.UIDs),
+			AllowedRoles:        newStringList(stage.SysACL.Roles.UIDs),
+			NextAvailableStages: []types.String{},
+		}
+		if stage.Color == "" && priorStage.Color.Null {
+			data.Color = priorStage.Color
+		}
+		if stage.SLA != nil {
+			data.SLA = types.Int64{Value: *stage.SLA}
+		}
+
+		for _, uid := range stage.NextAvailableStages {
+			name, ok := names[uid]
+			if !ok {
+				name = uid
+			}
+			data.NextAvailableStages = append(data.NextAvailableStages, types.String{Value: name})
+		}
+
+		// Keep the defaults null when they were not set explicitly.
+		if priorStage.AllowedRoles == nil && len(data.AllowedRoles) == 0 {
+			data.AllowedRoles = nil
+			if priorStage.AllowedUsers == nil && len(stage.SysACL.Users.UIDs) == 1 && stage.SysACL.Users.UIDs[0] == workflowAll {
+				data.AllowedUsers = nil
+			}
+		}
+		if priorStage.AllowedUsers == nil && len(data.AllowedUsers) == 0 {
+			data.AllowedUsers = nil
+		}
+		if priorStage.NextAvailableStages == nil && len(stage.NextAvailableStages) == 1 && stage.NextAvailableStages[0] == workflowAll {
+			data.NextAvailableStages = nil
+		}
+
+		state.Stages = append(state.Stages, data)
+	}
+	return state, diags
+}
+
+// NewWorkflowInput converts the workflow to the input for Contentstack. The
+// transitions to stages are resolved with the given stage UIDs, complete is
+// false when some transitions couldn't be resolved yet. These transitions are
+// left out.
+func NewWorkflowInput(workflow *WorkflowData, stageUIDs map[string]string) (*contentstack.WorkflowInput, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	complete := true
+
+	stages := []workflowStage{}
+	for _, s := range workflow.Stages {
+		stage := workflowStage{
+			UID:   stageUIDs[s.Name.Value],
+			Name:  s.Name.Value,
+			Color: s.Color.Value,
+			SysACL: workflowStageACL{
+				Roles: workflowUIDs{UIDs: []string{}},
+				Users: workflowUIDs{UIDs: []string{}},
+			},
+			NextAvailableStages: []string{},
+		}
+		if !s.SLA.Null && !s.SLA.Unknown {
+			sla := s.SLA.Value
+			stage.SLA = &sla
+		}
+
+		for _, uid := range s.AllowedUsers {
+			stage.SysACL.Users.UIDs = append(stage.SysACL.Users.UIDs, uid.Value)
+		}
+		for _, uid := range s.AllowedRoles {
+			stage.SysACL.Roles.UIDs = append(stage.SysACL.Roles.UIDs, uid.Value)
+		}
+		if s.AllowedUsers == nil && s.AllowedRoles == nil {
+			stage.SysACL.Users.UIDs = []string{workflowAll}
+		}
+		stage.AllUsers = stringInSlice(workflowAll, stage.SysACL.Users.UIDs)
+		stage.SpecificUsers = !stage.AllUsers
+
+		for _, name := range s.NextAvailableStages {
+			if name.Value == workflowAll {
+				stage.NextAvailableStages = append(stage.NextAvailableStages, workflowAll)
+				continue
+			}
+			uid, ok := stageUIDs[name.Value]
+			if !ok {
+				complete = false
+				continue
+			}
+			stage.NextAvailableStages = append(stage.NextAvailableStages, uid)
+		}
+		if s.NextAvailableStages == nil {
+			stage.NextAvailableStages = []string{workflowAll}
+		}
+		stage.AllStages = stringInSlice(workflowAll, stage.NextAvailableStages)
+		stage.SpecificStages = !stage.AllStages
+
+		stages = append(stages, stage)
+	}
+
+	data, err := json.Marshal(stages)
+	if err != nil {
+		diags.AddError("Unable to serialize workflow stages", err.Error())
+		return nil, complete, diags
+	}
+
+	contentTypes := []string{}
+	for _, uid := range workflow.ContentTypes {
+		contentTypes = append(contentTypes, uid.Value)
+	}
+
+	branches := []string{}
+	for _, uid := range workflow.Branches {
+		branches = append(branches, uid.Value)
+	}
+
+	input := &contentstack.WorkflowInput{
+		Name:           workflow.Name.Value,
+		Description:    workflow.Description.Value,
+		Enabled:        workflow.Enabled.Null || workflow.Enabled.Value,
+		ContentTypes:   contentTypes,
+		Branches:       branches,
+		WorkflowStages: data,
+	}
+	return input, complete, diags
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentstack/internal/contentstack"
+)
+
+func TestValidateWorkflowStages(t *testing.T) {
+	stages := []WorkflowStageData{
+		{
+			Name:                types.String{Value: "Draft"},
+			NextAvailableStages: []types.String{{Value: "Review"}},
+		},
+		{
+			Name:                types.String{Value: "Review"},
+			NextAvailableStages: []types.String{{Value: "Draft"}, {Value: "Approved"}},
+		},
+		{
+			Name:                types.String{Value: "Approved"},
+			NextAvailableStages: []types.String{{Value: "$all"}},
+		},
+	}
+	diags := validateWorkflowStages(stages)
+	assert.False(t, diags.HasError(), diags)
+
+	stages = append(stages,
+		WorkflowStageData{
+			Name:                types.String{Value: "Review"},
+			NextAvailableStages: []types.String{{Value: "Review"}, {Value: "Published"}},
+		},
+		WorkflowStageData{
+			Name:                types.String{Value: "Archived"},
+			NextAvailableStages: []types.String{{Value: "$all"}, {Value: "Draft"}},
+		},
+	)
+	diags = validateWorkflowStages(stages)
+	assert.Len(t, diags, 4)
+	assert.Equal(t, "Duplicate stage", diags[0].Summary())
+	assert.Equal(t, "Invalid stage", diags[1].Summary())
+	assert.Equal(t, "Unknown stage", diags[2].Summary())
+	assert.Equal(t, "Invalid stage", diags[3].Summary())
+
+	// References cannot be checked when a name is not known yet.
+	stages[0].Name = types.String{Unknown: true}
+	diags = validateWorkflowStages(stages)
+	assert.False(t, diags.HasError(), diags)
+}
+
+func TestWorkflowInputTransitions(t *testing.T) {
+	plan := &WorkflowData{
+		Name:         types.String{Value: "Review"},
+		Description:  types.String{Null: true},
+		Enabled:      types.Bool{Null: true},
+		ContentTypes: []types.String{{Value: "$all"}},
+		Stages: []WorkflowStageData{
+			{
+				Name:                types.String{Value: "Draft"},
+				Color:               types.String{Null: true},
+				SLA:                 types.Int64{Null: true},
+				NextAvailableStages: []types.String{{Value: "Review"}},
+			},
+			{
+				Name:         types.String{Value: "Review"},
+				Color:        types.String{Value: "#2196f3"},
+				SLA:          types.Int64{Value: 2},
+				AllowedRoles: []types.String{{Value: "editor"}},
+			},
+		},
+	}
+
+	// Transitions to stages without UID are left out.
+	input, complete, diags := NewWorkflowInput(plan, map[string]string{})
+	assert.False(t, diags.HasError(), diags)
+	assert.False(t, complete)
+	assert.True(t, input.Enabled)
+
+	stages := []workflowStage{}
+	assert.NoError(t, json.Unmarshal(input.WorkflowStages, &stages))
+	assert.Equal(t, []string{}, stages[0].NextAvailableStages)
+	assert.Equal(t, []string{"$all"}, stages[0].SysACL.Users.UIDs)
+	assert.True(t, stages[0].AllUsers)
+	assert.Equal(t, []string{"$all"}, stages[1].NextAvailableStages)
+	assert.Equal(t, []string{"editor"}, stages[1].SysACL.Roles.UIDs)
+	assert.True(t, stages[1].SpecificUsers)
+
+	uids := map[string]string{"Draft": "blt1", "Review": "blt2"}
+	input, complete, diags = NewWorkflowInput(plan, uids)
+	assert.False(t, diags.HasError(), diags)
+	assert.True(t, complete)
+
+	assert.NoError(t, json.Unmarshal(input.WorkflowStages, &stages))
+	assert.Equal(t, "blt1", stages[0].UID)
+	assert.Equal(t, []string{"blt2"}, stages[0].NextAvailableStages)
+
+	// The stage references are converted back to names, and the defaults
+	// are kept null.
+	workflow := &contentstack.Workflow{
+		UID:            "blt0",
+		Name:           input.Name,
+		Enabled:        input.Enabled,
+		ContentTypes:   input.ContentTypes,
+		WorkflowStages: input.WorkflowStages,
+	}
+	state, diags := NewWorkflowData(workflow, plan)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, plan.Stages, state.Stages)
+	assert.True(t, state.Enabled.Null)
+	assert.True(t, state.Description.Null)
+	assert.Nil(t, state.Branches)
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRetryTransport(maxRetries int) *retryTransport {
+	return &retryTransport{
+		transport:  http.DefaultTransport,
+		maxRetries: maxRetries,
+		minBackoff: time.Millisecond,
+		maxBackoff: 5 * time.Millisecond,
+	}
+}
+
+func TestRetryTransportRetriesRateLimitedRequests(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newTestRetryTransport(5)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"foo":"bar"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, []string{`{"foo":"bar"}`, `{"foo":"bar"}`, `{"foo":"bar"}`}, bodies)
+}
+
+func TestRetryTransportStopsAfterMaxRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newTestRetryTransport(2)}
+	resp, err := client.Get(server.URL)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryTransportDoesNotRetryNonIdempotentServerErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newTestRetryTransport(2)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryTransportBackoffIsCapped(t *testing.T) {
+	transport := &retryTransport{
+		minBackoff: time.Second,
+		maxBackoff: 10 * time.Second,
+	}
+	resp := &http.Response{Header: http.Header{}}
+
+	for attempt := 0; attempt < 70; attempt++ {
+		delay := transport.backoff(attempt, resp)
+		assert.True(t, delay > 0)
+		assert.True(t, delay <= 10*time.Second)
+	}
+}
+
+func TestRetryTransportRetryAfterIsCapped(t *testing.T) {
+	transport := &retryTransport{
+		minBackoff: time.Second,
+		maxBackoff: 10 * time.Second,
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	assert.Equal(t, 3*time.Second, transport.backoff(0, resp))
+
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+	assert.Equal(t, 10*time.Second, transport.backoff(0, resp))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	delay, ok := parseRetryAfter("3", now)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+
+	delay, ok = parseRetryAfter("Wed, 01 Jun 2022 12:00:10 GMT", now)
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Second, delay)
+
+	_, ok = parseRetryAfter("", now)
+	assert.False(t, ok)
+
+	_, ok = parseRetryAfter("soon", now)
+	assert.False(t, ok)
+}
+
+func TestTokenBucketReserve(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(2)
+	bucket.last = now
+
+	// The bucket starts full, so the first two requests don't wait.
+	assert.Equal(t, time.Duration(0), bucket.reserve(now))
+	assert.Equal(t, time.Duration(0), bucket.reserve(now))
+
+	// Subsequent requests are spaced at the configured rate.
+	assert.Equal(t, 500*time.Millisecond, bucket.reserve(now))
+	assert.Equal(t, time.Second, bucket.reserve(now))
+
+	// After two seconds the reserved tokens have been paid back.
+	assert.Equal(t, time.Duration(0), bucket.reserve(now.Add(2*time.Second)))
+}